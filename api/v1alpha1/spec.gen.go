@@ -18,92 +18,208 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x96XLbuJb/q6DYtypJNylTq239q+tfiqV0dK+36yXT062MCiKPJCQkwAZAO0rKX+cB",
-	"5hHnSaYAcBdly46dpLvzTRZB8OAsv7NS/mR5LIwYBSqF1f9kRZjjECRw/dcBljhgi7GEcOyfYrlUX/og",
-	"PE4iSRi1+tYlJX/EgIgPVJI5AY7mjCO5BOSZmxGREFq2BR9wGAVg9S0R4iBwrtSXRG0RqY1ti+JQXfWK",
-	"z7Rsi8MfMeHgW33JY7At4S0hxIZWKYGrHf7rd+x8dJ39t8+TD87bT67da96k37/4//+wbEuuIv18yQld",
-	"WDc3dumAVEhMPfi8gyKSbPPAE2dEPPXJz4FfEQ8uVtEDTizMzUhvWzzopiOK4tOe9mg3ancRMSpA6/Ag",
-	"4ID91egDEUbFPUYlUKk+4igKiIfVeXfeCXXoT/lhFDskJoHVLzILXRO5RMRHz65CRwnLx9x/hrB5CgLz",
-	"GMWERA/6luv1dhfL3tLZhf2es9v1wIH2cs+B5qK3117OO/t7ilVCYhkLq99x921LEqkZegaCxdyD9Qck",
-	"5x4cno0Gw/+cjn4dn1+cWzdFXv6Dw9zqWz/s5Da+Y66KnRHnjBt2laWe8AslDLuxrZfYP4M/YhDygex7",
-	"RSDw0bNECaaK8mcojIVElEk0AwRhJFdlpu3utzv+vA1OZ9ZrO53W/syZufOuM9vz210XvGavCyWmuTnT",
-	"xvQKB8RH3FCNCqCW8W18/GZwOB5OB2e/XB6Nji8egXMvsY9SRt3Y1ivGZ8T3gT6Qa5cCOPIZCM2lJb4C",
-	"FAEPiRCEUSQZwp4HQiC5JALxRE/KTNzDnS7MO3On6+12nG4be47XnPccbx86vebcb+325iUmtnMmDszu",
-	"8+wUGetOR2dH4/Pz8cnxdDg6Ho+Gj8C7nFk3tjWmCgJwoMwOuLnnYTwcUBRT+BCBJ8FHoHZCzPNizsFH",
-	"10sSAIo4UwcldKGhLdGZMh9bsLdP3u29c/YXzT1nfxcWzqL7znUWbbLndt8te033XYGP3bIymsNo0ARu",
-	"iCjq4cXo7Hhw+Ag8zJ5k+IaShbZ1zOQrFlP/EdCvjHqZdmpUKvNsf9btzRfdhdPz97pOrzPzHb+12HV8",
-	"d97dbS2gvbe7KOlepwb11N5zTXrGsOOTi+mrk8vjx9C6YyaR4cyNbV1SHMsl4+QjPJRTbzTsqG2UyzQ3",
-	"II+D9qA4EAhzo2HK921nwj2v1fah5Ttt3G05ndYednDP7Tp41291XH/mdjt+iY3NggmXCcmcbsbLy+PB",
-	"5cXr0fHF+GBw8Sh2XGLiTbZfNZrUnp6zCLgkxk3jiEyvgAtiuFve9Y25gNhc22hhI2T2R0QKCOboOTQW",
-	"DRtdNXEQLXHzRWNCx2EYSzwLAOG5BK7EodnRmNBy6JLcY9nFGOTqdxVp/KRCjrc/mc81QYdt6V1hKkkI",
-	"6+RfkBCExGGErpdA12PGaywMWeCj52evDlC73d5/UaKu5bZ6jtt0mu2LZqffcvuu+5tlW3PGQyytvuVj",
-	"CY5+ugp/sH9Cg1UaXK0R6xMRBXg1NcHZWtgngDtzToD6wQola5FaWxvxNib0KGUw9XNIoGBUfAYo1oFk",
-	"leHnKihGQ7iCgEUhUIneHFm2FeIPh0AXKiDttWuIj2pj1Qwx1GVEDJMNd/opuY4iV+x8KmUYNxWqymsL",
-	"gXtBKcprtgtT7xSKiMC7y+wKin+ult/YVkz8h+YqDXShcGeuozMiEItlFEuH0WClRDmhZJPpoIsloPEQ",
-	"eZgq+TL9XBwEK6ROoZ7ooyuCJ/SPGPgqj7+QgUO9yf9DZK4VJeLsivjg21lqARwtgALHEgTC6PJyPGxM",
-	"6IS+YkHArgUajE6dZquVIaYmhdErdVpGRVXRel0X9jqu64CKIjtNv+Pg3WbP6XR6vW6303Fdt7mueCGh",
-	"6Z9N+/5pyZ3yjiP/8xAjwEKikPmG3VvgRrff/BzcuCmmbb+XALsCKYkyv822YLN34Kn44IODIXIyT5fn",
-	"e0JtWW+nU/XnlPg3asMoiDkOqnaqnkjoIg4wr1zKsTr9NsQUL4A3fC9sELZTWryhJPBo3ird8LvX+tpe",
-	"K6vT/Mncl5PSXfFjWd3oNn9WuPlux1ZY/FgerpD/T9Pdp1s6sMSYPMZNdcdXCVuxDpVJdEJTrTSCJ2Kj",
-	"5G/1f4hstsG/mC+6Z+yRatsjxCC5NL4HI9+DkW81GKlB3SQqSVHstvAkv3tznOIU+gbbByz5XRsil0Ni",
-	"irbl6IXCBzmN8AKmkr2HmgjmQn2t7ZWD5ASu0uqYuhOpOxsTOgojuUJGIIhQn3jaRDTgEqGXa61Ilpc0",
-	"AVb/vPot/O3jb7/+m5y8u7ye//vnn+sCFA4iDkzZvlKl5hyvlFOoBZPMGHUlXkeI90c39fyEIKyetqZ0",
-	"KXH2GkPXlK1eOucJ7JaPdm5QKynaKCHg+lPayIc5oalsSms4zIGD9obKlRlY9Ridk0XMcQGZyppRCblr",
-	"NCMPaM2DxsNbXGxOhrhPTBvWqUIsgE+vcBDDbeqgViGz6m73v61yqNjyjdrzTpWo8q9M9h1q8Tcz1s+x",
-	"0aezzYfZZMUUS3HpA01Rr7uNmXUb1eu8kj/2luW1hmIQ6lshOSZUCpMCwRwr3um9DBUTmqQkpYOJIlPu",
-	"YU66HXhQpEXJICR0bO5uVmVbTh3qQem8SNm61T8aEFX0rESYnQqtTseyNlaZdv01SjvWaK6jSqVHKoTa",
-	"3XN30SlnswBCNNRdBiOW1xcXp2hwOhZGp3QMut82HR90lra/6yRUVrK0c1Gl6nUcYuqoGEyzCT5EAaZG",
-	"bdI9VXKs+Zz00xTcJ0mabnGpxBqvlHZJTGjaV3Oy2/3kOJKhJQQR8mEWG+shQqyn21v3kNdgiRSqONul",
-	"KCTnXLlnaNzGgUk0YpGmmBx775XIjPXM4sWC0EX1AFs2tLNgOObEybS27lxpy2dNdko3zEXkMR/Q8xBL",
-	"bwki6W8mmmZWlAJ03UTPCCBUtlv5gwmVsADdT0z6S2sguWRc2mhZ1h0RhyHmq5JuaCttTOj5ksWBr5ip",
-	"QIgICVQi7HEmimol0nsFDisblDi8Tds/Z189lBxhb0koFFRfP07xsYEulU0NRpq7ugNauJomejQOFS6s",
-	"jRfYa802u9DKtKtzHHZNl922zkbnJ5dnB6Pp6NfXg8tzs8urwfhwNJyeno0OTo6H44vxybHa7+XJmbl+",
-	"cnkxPXk1PRsc/zLSZIyPTg9Hiih9OWtAawrfDMaHg5eHauFwNBgejo/Vww5Go+FoqGCtwO31E26ruxUM",
-	"TbAz0edUveowtMZzrMVLiftaF+3QXDDRYW7pGrIbE6rb2z5EQH2BWFKBU9eeibRc+zwpMZhz2IjG4Qy4",
-	"jWaMBYCpjQylNtJ+S5dx5wh8on3Nz3McCLBLIdecfADfEFRZrJPe0lpCiSQ42BHxYgFCFu4rGkHLtmgc",
-	"BGoPkzlvWTjFngKwAM8gqLAGEYouxzsHh2NDIguJlOCriIeTKwWBnIWaQl27TGrZE511N668KG54LKZy",
-	"YqH//e//QRPrjRfF6MB89aJqwgenl+baFpXUlFcloRsmV474H0uQS+AIqK8TBKFrRbpasSqe1GiGLnIk",
-	"GFIoMgpz/EyKkNeqjBi1P4Q0fKqVTqmUkWjN5qLwP89Pjg1Tk60zecg84LlIIzEU6xkWn2mPmHr8kXm0",
-	"6NdJJBNTCCHjq4YgH2G6mJkLIUjsY4kbWilEQxLgE6sir8qWdTirMVmTM81nC7DvE1OmOy0Yr2FPDRPO",
-	"jf0VI1WlpOnWOurOpPjc53guUcttuU6zpVTsRBcRzQyHwnQt4ZKpKV8URxHjUuTgXnz0e1hdM+6LvvY8",
-	"NgoJJWEc2ijEH/SHCU2KRzZSPkCvMOqr16QfQXq6eniWomMfLaWMRH9HD5Y4hkUNxhc7+hg7yTGKV52c",
-	"pWVxVBXoWOOT8p7KrjzGQaDnTafZe2HMSxFu9Zs9HXwnf9hWGAeSRAGczIuheNH9l2G5guZal+vA+zXg",
-	"wKh5GbDrlf8AU0aJhwNjAUkEUJiRyZVwaTbeps69KWTSO6DMA1X3Xt2dB5hb711lTGgvlg6z4yh7DkAq",
-	"CpMp2bx2mC26vViYLKsM/H5eP7OcAyaesdzBVJ9mIM2Hb7edmZXs79nKdPvtz2tlpti6LggDtpsh8lNN",
-	"X7R0zH/ByjF+LMKEG5z0sIQF4+SjyeBMFSKQwE2a8pLJpQI4k/4nxTPM0zin2sLQVUK138rqWxTkNePv",
-	"SzF1ERnWUOAB/c9E4Ry1l9j5VJolv0maeEmQ5GWoUdN3SsVdVbry/oUBx7IWlpc9QU+0BgQDLERed6ox",
-	"QJWOsjBkNJUboV4Q+9BHV6GdJt8qRlXqNsMqdPGCWEhQ+fnAV7ivXKpkXKAQr5KiEPJiIVVUp46KZrBi",
-	"1FePFrBdrTbtJ27v5hN0yssD5VpVCjMp5L5o5HLHFLEIq0TeJ55+Gs/KDtUmcb6/qdRoX5zGSGi2Ki3u",
-	"T6iD3hz1kQpwbGSCJBsJyThegI0WKkI8ObeTKU61+iBleB+RUC/Kqpx2Omlso8Ro1A3DRCx9BHRBKNgo",
-	"geHCnXpjI7R+fpmqpBM9VwflLEBRgNXdal/g4oU618VSm3DsyZgDusKcqDNiAX6a3xS1Txu/4XPqCtYM",
-	"37BAfUpCRau/p92q5ojWXyLeK8emQCLCHpErvarrZq+CzBgrxonCt27eqjDRi2KtMtxbEgmaZqtvfdjr",
-	"TXsdy7ZMfNlv1YLKPRvQJQP63nf+E/WdSx773j3nVr/Tfaqec6UQ/LCec72nSwZmKh3m0tpyY7l46c4Q",
-	"sbS4Eig+WUdKubKkRXP/5tSJQXv9cOQgnxkLwlwA0tU4alAPhZjGyiBvb2iNro9euw9saFUaPQlgJ6Xv",
-	"tChtbDw9L9LVWH0oDQz3aJwUQ/jHbYDlHc4t07O12kTeeE3Dt9KU/rddoIhr0OdNuUyYn++paoVl2KrP",
-	"q1Nq12V4ozsdc5a+uYI9Zblr6YHyWcODo6yHf2TAAA1Ox6kPUt4mjYDJR/DRNV4pKRvcmNCSzpu2p+k9",
-	"qgCi2HQzyQehc47zMKRQTUtCOPXoee7U0HP1xYguMfVAz1Wq2JEJHIgXGV166wlNLc5hnABV2ZsPgizM",
-	"hN4PP6CzPIRSQdSPPxYsSPz4Yx8NTbgrIYwCjTmKYp/MdXFGJvEvm286xIQi9PzN0YZA+1/xDDgFtW0S",
-	"c9sanwqx9QtDVsFUNFkHKu4FP4MXpghSqZh5+7QcxFZawIomLYm8WKZ1KyAeUKEVPYnEBhH2loBaDdey",
-	"rZjr2kNSi7q+vm5gfVmXopJ7xc7h+GB0fD5yWg23sZRhUOgGWRvUSulsWlnI8/sb22IRUBwRq2+1G26j",
-	"Y5KtpcacnQ3jU/1P1gJkXfqo3YxW3QgvCNXcC4iQG0eERLHkl2XDKgWon2TR0ZelqTaMHvtW31IOsmaw",
-	"R+jD5G/S//5ZHjJ9pVq7i/yd6gKkF1/bWgta1ltcuvCXIJLWbm2sUrkoGXOKIuCahg0PDvEH408UHJee",
-	"nZXhm7WdxLzk6KrrxaJjtcq4TvYrLaMNwlyTmxaXrvuaM4nkkNdL4KZe3qjM6qC8S0pEbfV+7TX+Cl/W",
-	"h382S+Vt5S31lutu8c7hdi/nbZoDrHld7zzWqes8DrLGsDLNjtvc9JCM6p3yq37qpvbdN5Xe8+2aQ99+",
-	"R93LwPrFQtNXToxwg17oihMTNZBxoMt9CjAoXG+cECtghAoAnDyzGw+Fyu600T7bNBP6DFVzP+0RfQgj",
-	"JoF6qzpMMZTVDSTeASonSQZaJXUToN1HtyvqXMkE7/kTDW9NZANCvmT+6in1Pv09iOKvTdysmV7z6Umo",
-	"NjfqJJJWoEVmlMHKGNbjYcMtL4+XhydmzF+hdAAMGc/85ZCh4+7ffUf5lz0eD0+MAW6autWLd+73To6B",
-	"nwAk1I0lBGCA6JYx1TJCmFu2Qog6XuRLdjb//k2Nh+rUdenqFNkctU6Rv5DydO6+I/tNhMfTGyOWzXpj",
-	"3x28mpbfBsSerRCRYkMk+gvIL64Q7reBm/NUjn9x/foF5Pag9BjJ0uYcqdKCuisv+p4PfZF8SNSI5vYc",
-	"qNQAujsB2hgeVqvfXzvv+XvlOw9Kc7bPbh4rj3mU/OUvnbZ8xXTlTnf7PTv5hrOTGv9f/Z2b++cgW6Ue",
-	"nxVhPjjV+J5hFGX/wMTiHvnE00jZ/SpA9vdNF5L5O6/ut171IIaotJZ076uScpguru7/HgFfADrV/Ww9",
-	"frHb3u+90IHFMZOA5BJLVBiTMCNGa3En5nDbb22sqaah9Sm0cxvnHqpDO5qNPz2xo/869mFmcr6yozdE",
-	"ZL/G+de3VqPUtW59mQ3O18J8MrzuLcF7r+PyzY3YNYh/nY/OP5HmvU4n0G82jHwiIlA6ZV9mSfFghhPl",
-	"maeHFTg2jS/UvgKS3K6wy+CeLkXoIoPYVPcozhg8at1DZfMzPWZQeOmqMt2TRMQatiMOV4TFIkt1k1Gj",
-	"r1I7MW9PUSbz6Ug7f9VcMtR03c30fZESy1MGKNWhuvvUJrbAl8JPdP85yxlFq9y6nLHBlB+7sjE2Y/fj",
-	"oYKqjcO61yQIsoldxChsrokU5/geWBMZD+unmSf0KBYymadCw+Nzp9lstfMXO0Ms0fOAXQP3sACkp3Fo",
-	"HAInnpktWq6iJVDxovKyZ/1UMs2Shy1qhH+GWkxpwvLL1mLWHl3vLbWuf5O1mPytxOQ3zv9uBZnSf8VY",
-	"j1eqby5tFb8keXsJ6e7K22+Flzsyo/V/C/Kl3OKdSv/3ytsrypS8KpZK0Uxr7uCI7OQjlW9v/i8AAP//",
-	"vkb6Os5nAAA=",
+	"H4sIAAAAAAAC/+x96XLbONboq6D4TVUnPZQsr0nUNXXLsZ2OvvE2XjLzTSvXDZGQhA4FagjQtrrLf+8D",
+	"3Ee8T3LrHAAkSEKb46TTnfzpdkQSy8HB2ZffgiidTFPBhJJB97dgzGjMMvzz6IqO4P8xk1HGp4qnIugG",
+	"/2T0A2FCcTUjio5IOiRqzEjGphmTTCgK74UkZhm/ZTEZZumEcCVJlArFhGqTnuqLCZ2RASPwPhnQ6APh",
+	"gvSGrdNUsNYJVdGYqJTQ25THRGVUyCHLMi5GhAqSi2hMxYjFfZExmeZZxAgdUS7afRGEAbunk2nCYKEb",
+	"/WA7ejncpFuDV3GH7Qx36d7gRfQyfsU6+Ot21A+CMJDRmE0o7FTNpvClVDBZ8PDwEAZTmtEJUwYkB1TR",
+	"JB31FJv04nOqxk34XAv+n5wRHgOMhpxlZJhmCKJIf0y4YpPKSuWEJknrFn7kMMQUBg4DQSfwNHLnDMIg",
+	"Y//JecbioKuynLnLn1KlWAYj/O+faOvXTuvV+2fmj9b73zrh3uaD/f35//pLEDb2G1Y2KKSiImIft1HC",
+	"zTCP3HGxiE+98zecJbH8R86yWXOvB+lkQluSATYoFhNYr7SoP8QvAWMzpvJMhIRKwkVf/Kyf/C3ncRhz",
+	"OU3o7Aa2GMopi9qSZbc8YjewlJ/b5EyNAYR6LJoxkrCh6os0V+UVk9NUSNYmZ4IkXCqSTlmG903iC3pV",
+	"dDpNZrAaRqOxc0m4ID9nTOaJkj+3ybX4INI7Yb/JGOEjkWYsbpP9JHHW0Rd6Vywmd2MmiEgVgfXDkce1",
+	"O/dTkHM4KHezcMHq2w3ew0fTJI1Z0B3SRDKDB/9B8BeIoFdRuaKAU9Jz5Dfv//qs32+bP59/7znk4gea",
+	"ZXQG/5Zqhug4TLMJ/Ls3BAqEBOgt0sEmJgBRlH6ipw8hSjgTitAkYzSekTGVbfJPAFwqGEmHfaHGbEIm",
+	"MAczX+RZBp/USeh2Z4ecpoqcpDECm3DpHgZXY0AOSgZpPHs08UOwa6Jfwr1CihdSyDC41Od6NZs+glIY",
+	"pCA4rLv+eaRBurN9WpLwEAb2xiHC7esTPbrnUrNKw9HgT7hzPMJz2/hFwqZ/KzcD4FCUJ0HXBRYeIOEx",
+	"+e520gIiF9Ms/q7AG6anASAY+tkNOtHei9F4b9x6wV7ttV7sRqzFtscvW2xztPdyezzcefUST0tRlcug",
+	"u9N5FQaKKwToRcEr6xOYfe8fXxztH/7PzdG/epdXl8GDC8u/ZGwYdIP/2ihlhQ39VG4cZVmaaXBVT93A",
+	"ixiAPYTBaxpfsP/kTKpHgg9pNPnOJSXfkUkuFVKlASNsMlWzKtBevNreiYfbrLUz2Ntu7Wy9GrQGneFu",
+	"a/Ay3t7tsGhzb5dVgNYpgdYTtzThMcn0qokjDBRw652+2z/uHd7sX/x4fXJ0evUEkHtNY2IBBZwpzQY8",
+	"jpl4JNSuJctInDKJUBrTW0amLJtwKXkqUNCKIiaBFnFJLLuoAvEl3dllw51hazd6sdPa3aZRK9oc7rWi",
+	"V2xnb3MYb73YG1aAuF0CcV+PPix2UYDu/OjipHd52Ts7vTk8Ou0dHT4B7EpgAT0XQAJoAteOZfqbx8Fw",
+	"HwRPdj9lEbB/BiORNELKDXyRJ4xMsxQ2CmKq5g36ACtw3GIvX/FfXv7SejXafNl69YKNWqPdXzqt0TZ/",
+	"2dn9Zby32fnFgeNuFRn1ZpBoskwvwsXDq6OL0/3jJ4BhMZOGGzEvhsFpqt6kuYifgPpVqV6BnUiVqjB7",
+	"NdjdG452R629+OVua29nELfirdGLVtwZ7r7YGrHtly9GFdzb8VA9GHuISy8Adnp2dfPm7Pr0KbAO2LSG",
+	"jIaSZdlNXugy9Da5aggRhWSgxQVEJS1zgERZYcya53sUNt8WzGsb+A7u4FrQXI3TjP/KHnug75A6wjBM",
+	"KPMBiTKGjJ4mWq60LHo1SrMXbW3HbCtubdPdrdbO1kvaonud3RZ9EW/tdOJBZ3cnrpz2pkNpqguxE5dH",
+	"fn26f3319uj0qnewf/Uk5KYCxIdivLqyCP+cZiCuK66lCTrlN7csk1xDtzrqO/3Anr8zENHjE64kS4bk",
+	"GWuP2iG53aTJdEw3n7f7ojeZ5IoOEkboULEMjgPBUZcQ7TdB6IpKtz+BQPRXkIze/1X/7ZWkI6rYKM3M",
+	"ZmrKUvHMow1K1FxYTHIRs4xwge9IlWZsmKVChX0BeyJwCLliJM1ITBUdUMlkmxyndyyLqGTkLs1iSUqN",
+	"bDAjMZVjJhsqiRkpgKO7ZUk6nTChQAOZp0qsLSqGwYTe9/Rom3tNNQOPgN0oPmFNaF3xCZOKTqZavWpA",
+	"7I5KfYYsJs8u3hyQ7e3tV88rR7nV2dprdTZbm9tXmzvdrU630/l3EKJWQ1XQDWKqWAtnDwOQyc5EMrMC",
+	"c/Nk9Vw3A48a7FwwUIIzLiI+pQlRY6qKRRpVFe92SHibteGnvojSySQVBAR5QGy0CWldKYJrMcRR61ga",
+	"8daUT1nCxUqLryy3vvrjVIxaABQSp1E+KShuOiR3sIFcskyTrDSLGQwZItE9wIWf0OxDm1zQO/L26uQY",
+	"EBl4Ck2S9I7FXf2joiMZEipiknDxQZI06ws+oSMmyd04lYxcXxzrG8zsAGOlpiH+F14nE8oTlYZW8Z6k",
+	"t1bv9l0lvDZ1JTzYJ2heIe9OUM/6/nsH77//Hm7Oh3Zf9MUlYzjqT5L/ClLLKOcxe/8M19Ld2DADtqN0",
+	"sqHfeE4GbJg68NEzT+j9MRMj0Pw297Zf7vjOxTUINPVDybLWMONMxMmMmHc1ovhMSu2+OLEkTsSl7CCY",
+	"ZjIDRnLUOOuAuUSwHJbQIO9Oquvf2/YsnkepWMYgXLsZvI6WQ58uXEgk8NiSP31Tu3aXLaRMG79VLH8P",
+	"9ZtRedcxqDnUrPrOarRt6R0Da84a4LiE1x80x2brfIfvP4RoUnqk8REELC61MQvuS5qraa5aqUhmgDpw",
+	"OecwS5TMeockogLwKcV5aZLMStsXueW0L9BiVSqGJBXFID8QPkTEnGbpLY9ZHBY2D5aRERMMWJcklFxf",
+	"9w7xSr5JgZ5Isn903trc2iroKC4lFbew21TUeVywt9thL3c6nRYD9XZnM95p0Rebe62dnb293d2dnU6n",
+	"s9lE9AkXxb0N12eCSxEln8Yfx/YSKhWZWOvXCsxvt7v5ccxPL/kRzK+61N+TBd7RTHAxksuu2j/te9rQ",
+	"Zc1oP1UkU68pN3hfTJsOfmGRCsLgvkXZtGX37AhVEob007Ub+OcNjx9gwGmSZzSp0zWYkYtRntCs9qiU",
+	"wuyvEyroiGXtOJq0ebpRebnu2oh88gH8SuQYrOJzPBmOnCq7hHE02aux5uvpkFBh+H0IvJwaPpxPk5TG",
+	"LCb4qE2O7mmkkpnV8PIs0XyMKorGrL5A15hqk+vKl1o2QfKB8u6PR1d9sbGQZ2wA78LR4VOgRYX9WLOe",
+	"vqhuEdUKjYFVfQVW1wTZayrZ3g5hIkqLZYYICbgNUpG9HfJ3/pqkImIkZvgWeABlXxh1E3eslV4yYTGn",
+	"aNXTKyju8GCmWJV+vXzhETTC4C7jipXXAyTzYszm6k/gGdqeYcmwwx9KbRXJEvyGghZTQRgwkU8AnXGb",
+	"G1OcUf/9y5SV/7hjg2nw3r3C7gdNmpMlzaW9vbo6v7RoBRgGB1khCz4xDV6SG7eTtp6qgF+e8Sr4tjo7",
+	"Lysk347mdY1VL7vfS/hkGq4d8IvRdL8pb59LeXtKJaHwO39ubYHdT3m2CF8Mtt6NeTTWV9tiPJckZigs",
+	"wt1gsV4wS5iCaIcSf0LcsaQiHqT35qVJSpi45VkqYKkSiCwpoiwUSUVflHJpCozrjktGuNKzOsEadUh+",
+	"J/vCLvFGqSQEsZaKWZvYqyoLZyQHuKJxGqFQB6nF9a3Oclxv2liYopYLVSlNQgcswb9oHHMtqZ9X3lhs",
+	"KAj+zmatW5rkjEwpz9BXQayF61eEGUJ5yBNl9d5jnJNIlmiXwGDmARxI/lM6wiupF6kV+/JnwED4zj11",
+	"UNCB79fFfDDRStWKmFAMxaGotbm1vQOwYnSCrtIZHn7QpNk+Kv6RCmrLIkVN6igiNhZprM7Hy1VX5+Wn",
+	"0mEdD+JNgdwrapqGZ0Vppv3DMRejiie7uNHmzrHYkCgu59KohYoq4fNZ3Z9MaVzTumCxzbUy5PIxA+gP",
+	"P87eUB7oN8PDN8PDN8PDF2V48PArY4Gw9H+RKaL8er5NouXEOq5unCi/mhOAeZCkgjmBM1XsOLtlWcZj",
+	"pgP/eClSRIkxMNB5NGpf6ICZvtDg1J/IqlBaxNt6rQILRfZDV0g3XFOwO2cFh2xIISDRrtkV6/vCfIKr",
+	"il1muVROJ8+2nq8gq4PH5wYFP+lXOIh+SDI2TWikQztSyYh/ZTqkCx5IXD9IUJqgo8BNcmdEmoEEMeUs",
+	"rkO4cEsuukSwuHcwUjOscUWF/XCRq2x/NMqYFlBvObtbgEUonrhkRxKugIj2BXqJjFbhQx73Eq7BsWE/",
+	"3LE6rMnoA6Q6Apd2Y6UNv96gCV2NfdSiFpEtEztgIRXYBWIsMIa5awzvi1LSzxry/YRNUh1+LPmv7GY0",
+	"CLovH8LgNprmWvzPhQq6O94jrsTXLgGLE4jToMIFaN+vhkfHXFOl6tkKdq9upnTEblT6gXkw7Ap+RnBl",
+	"TGWc3dq4KfiSwJftvjgC6kT0fSVcxMimTPgI13QK+Z95vUIW2Oy/b/89+fev//7XP/jZL9d3w3/87W8+",
+	"tdKERHvuAFwnwHw/3hf4vuqVnYOLjcvrHoZdXNgA6Iqncz6m0kOZzy0SAmCn8M4iRtEXjvHz/Oj0sHf6",
+	"YxAG5xdn73oQvaf/ieGjQRi82e8dHx1WDaD2WQP680T5xoovtfRrQnuGaTZvtSGJ2ZALi06VdzI2ZBlD",
+	"xUzr8yCeR6kY8lFuAumXEaobn3ZwVZow9US9wwXaXrkMuY4VcxKsy8EKDHYZz1JN9GlYkIvFdfhVl70i",
+	"Jl8Wml1N/hkYj8jUxWn0Ni/G6Z7OdygUJ83KtCD+AxrN1DhL85Hh6Dg9kfnAXnwvpqRCMxLPQg8xao7F",
+	"pHwJ5Io0c8xHlT1EqVBZmiSWUaxGZezgvnwHdq8DO29KDPQZgOwzK5WVrL1hzigtHVwQOzyRMwlrXWPZ",
+	"R+bTYnLf8idMSjrykLO3+YSKFmgoeJHMe0S/NChIgcmzQHrXSJigXMGLloG/O0HjcJoq37WbWrK6JsHX",
+	"5Lh+P/RoS+7BV8ZnP4a9fjq2ep4xkIe9VtM8wUytqX4FILmQn1bP8XGCaCF2NiTSUOsilfljq2yhBcrl",
+	"CROWjVCPCQtx3jFRF3zLmLAxchPzmMjPcTQxlu2fyQc2e1KJ9qMNCn6bgfdE56rXrhaoUnu6OuNUqtoR",
+	"+052bQ6NKoMasxm5S/MkLvJk0VylpQMx6gv6GRn4Guz6cdJbTWiroPMjhTZ8bxHIfQP5pSOgkDQaV9/V",
+	"K2aY2CxVRrnQN6u8ZjCWXgVyR+XTHAugrHFumAV14K4FmSMXNgS5yTld/50nMJYPmeKljYYXfr102IRG",
+	"iIEwDKQYk9rYF5IpbWN2vJ/wsWvtkSVktFzBS2G3L/CLaZIbrhPnmQMWx+Dc2XnZ6ciqr0h776XXfT/N",
+	"ONhtlsH03Lz2EAYl5bspHYsNf6GsA8tSR7TK0qxCQZtOvr4ovHw6tAEFDgwf/ns+YJlgwID1kMbfqzIa",
+	"MTrgCVczTJrvCzMuGlq0oanwR5buRsJFlORxEfJTcI2+QNuFDsSHB3csSVo6LbhO2PUSyg1kfRGDzzjD",
+	"w8SU40bgPXolw4rT0o26X56dW7NoNPnfpXudmkrNk+lZdb7iLiy0lGYZYbTxr827F82ihM1TWRa4krpw",
+	"v6oRXPrEDy/231yVvFyPrI2MsqLVdKf5IOFyrElrl2bRmN+CAK3GKfqfW3osZ2yYmAAWAChVSljMVZpp",
+	"0kejiE0VEWl56WGI8+vXx73Lt0eHzjDOCGC+ha+tyysz2Nki+xcHb3vvKt9hrpSOgMsVGWOqn45bSLhU",
+	"tWX0BamSoB80YzUzmc2zWLPzipkDtw1GDrv2IAzscqq2DfeNpU4Skxsza0ryFSXZyEWN5LF8MtAO6OqZ",
+	"88KPpQd3lre55bikuFDbWyV6c6HYiGFmn9+Af+oY7n2DO7k1i2+MceJ49jjn1uBUfpVnriLh5B1ZQzzP",
+	"iPDDbA21Qm97VZ3Cu6FCKffErkh1gzVO8IWlTktzGvCZtUnoi/0UbkpPzM1qGrfOCJSEDtJclQss91VZ",
+	"0pXWr7kkWS4E94dFZoxKn2vihEZjLlg5t36xUNwXTfzu5GL+hHKOgUkbnsqoD3uUJa24ynKA3huaSPi/",
+	"Ka1RpRLmnXrA6n0LBmnd0gyuCPosC2QxnxT/tuMXPxQTFbjZwBsT29pYeUm9Splk6S02/M4Ayo/oUh1J",
+	"xSdeXmefgNQtlQ6YLuWnBucLizMd8VsmUEfqi6rKWnjcvJquTy8YZIx+iAFsTQJiVsVA2M8FV7jO0JJX",
+	"ZEwWmlauXNksVwLGb6IIA22fijwRAL3LM7KztfmC2FdIlMbVghnXl4de8ZdlPI1XlH7P9cuwtFTRZOkB",
+	"TllGzATOUrZ2XeqS5oPEQXxNjZsWYrv1YsV2DaFzYMswzp9pC0udf9ZFAZ95u+mssJt5sXRQDsVFGGbU",
+	"18rJYXUcbSdpWyOJ5yT/k1MsuuWJpYYbUdmLO/7L9U/DlFwpZtQyvBf8RU2FGqLAz0XBIjLE6CLQ7oFH",
+	"vXjZeUHOs3SQsAk5NJwDrjoEvZP9857U4ijGIr3a1uUHyIUZTPr05uqJ2/z0JUwLKhBRgaMUY2pVjUtb",
+	"3EFEBWSx3gJEE9MZmuYpF7bIQ6v43DJClZIxS6YkZoNc2zS4lM0Y45ULmjTQAc/55panCZ3jb8CxnVJF",
+	"uNJSPeW6tklYhkHhBmFrEcuE7ItiUziXXNdC8c6uzUfrXAf+apF2vDz4av0NbfQ60CJ9Lq13IqPRB10y",
+	"LtanMCoSVkv4r1gcxk2kaBVa5TpCBKK2ldfSmJFnbgWo4qLoNyoCHBakWUGCN0UQGtLLOM1USMZV1Jf5",
+	"ZEKzWQW1iUm8uRxbsyNYtrjELB0aZal0b0WBWJJOagNUILxKCZ26CWCpyGcxNWZtcg0kYf/onNhqIs5T",
+	"WXVdN0r1hI2KEKFTFiSs10QKPRVrwO99eXZ9cXB0c/Svt/vXl3oU7QS/Ob84Ojg7Pexd9c5OYbzXZxf6",
+	"+dn11c3Zm5uL/dMfj3AZvZPz4yNYFD4uirngCt/t9473Xx/Di4dH+4fHvVOY7ODo6LCui3p2uCruLpT1",
+	"LHp5WUDDdddgwT6fea8RUO1WqtO2xIo7sWalKWLf6zj0gQvPdH/nIi7Q3Q7slCBxlASeqZwmBulWztg6",
+	"5uJD3WG6yk5s5pWJ1c3abkLX7URulFut5XMtPkAEQwig9x2ax4bcODUjRfs82fhA+ytK8oxsAkLZQemI",
+	"2ZQJYD+iFEu+kzap65mxwOi1h0ZJD8HfmjAqQqJXGhJkGJjsNURLE1z/v+mKgRX35JDfs1gvqPYyGmIq",
+	"73LBFafJhsxHIyaV811F8AsDkScJjFEkE66SoaTDBNF0WgMNYMN1b+PguKeXmE64UiwOm+k3mHphMt76",
+	"WkAEj1kbTSb9gPy///N/ST94F01zcqB/el5bfXBwfq2frZKyZGBVOXQN5Hrx1zHDSEYmYlMuAw4Sw49n",
+	"7k41ZqBkYQi/kyMh9faLU2SlcVMfow0GddGstr+Kec1gzXw5/L8vz041UFXqTqhx0y1LpS3iWMQrTlEK",
+	"s1LmkZ5adn0nUhxTVY7XD2ziUlvb09uKs6wf1M6rNqSP7CAjxeXclFWLVncYIxAu8cOKzwqQ1A6N/rfi",
+	"FJ/FGR0qstXZ6rQ2twDFzjCBQVeHGiTmhCtXDQSIfDpNMyVLjuxO/YHNsHZPF8WFkEy44JN8EpIJvcc/",
+	"MG4W6GVIgHHjGxp98R37J1MRZi4UbKdLLCmFklUtDaJ2mo02cBsbZhvu01YJ0rrjep7hFe5VlGZMkmeb",
+	"rc09E2UMC9e1f8x20As3yRPFpwk7G7pOOVdmayRpNdSwucT7XbH0J6Lcn5b4zSVOS6iRj/poygPZjYZp",
+	"EjqPF70rg95EKlp2bLfObsZ+QZfZp6AwTUqy7qUvEWIJNEBpXAyOvnhW7J8Kt4JshdLWWYlhBE1IPCE9",
+	"qlEahKDeAu5LUsXl0BNdMu/mPeqqOajnPJ1/AQv91nP/FsTW/3M8qzI9o4l7DAOmRlRxalxU7Zzf2dzJ",
+	"7+aaCBYbpczU86xSi1CzBkE7gjuXD3RvGU3UuAmyahX6mvKM3xSWWS1aohnU6qCag1sGVamRjAkMf3tz",
+	"fXy8hgFDz3hgHwQPc117hUXDTxcOqEgFj2iiiUNN06kqAhoyq+RIzjMyaDgVOlt9bK8hKZ8qPmE3JqBj",
+	"Tsq65CJiLqhhkgyFVycYZBXwF2va3qsbNvZ25m++uMl1V/wcX8SyxDADbDfbq4A/0OyEqVTYNTjpXsVL",
+	"i/O7zGsPBcKXqOTxACrA5ZuJLxY3zUXcUhmfEjdAJxqz6AOCfsKThNuzc92+7ZXM8As8fBfaraYF8eLC",
+	"cUlyUWKT6wkWgkWm7uYQzHA+ZFvuZi7nqgxva0AGj70OS8afe0H8juwFLjA96ztvlOgxJKnBSrSZmKDL",
+	"S09d2AAde9Xr/cveARiTro+Pg/f1pXmdh+Xsr6nkUeCu502eJOgrPC9jouppIfiAWI3FH43CvO47LvsC",
+	"TugGH0Ikl/bf1Z1pJWs3iaiaDwJ6S/h3X+gBQLIRXIUkvcXsZmacM16fnp23Ivx2GrWhUbv0Ld7v+/He",
+	"oELM6Hhu0xO48Ny4tv3Wv9//tv3wlyf07AFMEVTS7xPTNSvSTMtwVpotDkzkE5YVLoGVI00FVzD48kyR",
+	"hh/Qd8Wqm2piMf5u6trglmxLDOduvT27vgjC4OTs9Opt1Yaqf/JA/JJBfJSO9PbElZZWPzTt6wBwid8Q",
+	"TNZvP0H6bDG0V4TQqbDnqVSjjF3+45gsIpurlP9YadZqBbKpnl22UDadkwmAg8wJ7HMttd6JzQm6SYyV",
+	"SLvqcboPfMwDlHkfGBJ2Sx3vH67kBzLmI9C5uCQDBvcUerEkMzJhVHAxGuao9prU17ISPEYGaWKqhQuD",
+	"EFWJqNN+ubW+s7YKzdCqMvX0edym7zK5WP2nzTE5qeORDMmAFYXnhjzTVedXIWYVMvC0mSYuSn9UPbVq",
+	"nLnRsasV1OCvAVP6jy+3nFpBVNcspdbpbn/VdbCnGcPVrW7Tb+CNKUemx4FaDfbvymvYk2iigyG0ezYk",
+	"NEnFyA3CrIRehhi6C3G55ue+wN9JRIUuUWksuvDxZEWznF2p93bYIBNd3NPINNpuhkQ59m+tybDnakvW",
+	"qDMfgNrkBgiiUhLrCGlG41p8ojSFOu9sGHQlXPt20rrdqjdNcrw7uxDt66GK04RGbMKEWmwNqqXdlCUv",
+	"OLajqCzVvNtCFNjAhVUJQvWFx3Zla5DIL6sK3OtUjcGjoePOndIeeg5PBbci/jsQTEEOSDX54YnrtVUP",
+	"4bdK96wHU3SM2zYN1lLlKXJVzXlegAXW5fPxuLBWDTePLpNQKcuUMw+Gt21lf3tuJk+mS24noY3wYllY",
+	"CNEhiZJcKkzH3o9BD5Qqoyo1vgidD0aiXCpw48JWyYDNUp2EIdkqiSbhIxJBjdBQxqBV09Qs97dSyfN2",
+	"ee5UkHRKIdwq5mi7oVkR21YvatdqhoOVTlHIX3df7kL2xruTLgELcki0VzTE8slYIXgELuGzy9A0hIG3",
+	"DyzAu7aMsCOrmd5KITGXBhNTzLF0CRMjLlhIjHTkfIkD60Prlo9FGoP/zuTXk2lC4WsYl2XyOewLU6lU",
+	"lkcqh1wVikkFMFlsAxpc7MPLr+FsJbRVE2ENRIwy+AFsOEAkpjTiaoZv7XaK5ne1bHQZBw/vnWRazN5R",
+	"DNccdIP7l3s3aFI1ySRbXqKyZrW7ygX6VuTuD1TkriJIr13gbqu7s/utwN2TFrirZRE+rsCdn8Gbuqa1",
+	"cnaVd6tV7NxHS50blZdrLUA/mQUBTtUo0+sbE840k8PJSQukbyQcNJPYYEpHw+SRIhMqcqBDiw0QR3cn",
+	"bzuPLHJRk7ENnzJh5TbgW5M2u19jpIBNOWajFW0VTqGvJzVVFCbddfItrFG/mniB4lc4L/+ivb4VfnEy",
+	"Bq0asf0mEyyhgW2cbeyEr+LAmkkc/qCDubkVZX2GBoxXDEIpq0pYxaDSSu7LjnXL2bxUlyKspdzfpwo7",
+	"rTLEeUeoV+s7wzJK63Uu4sS/JfMGyfKkKNDFKkW7lhVnW630RX2qEN1qTCiQsWzz8ar6sF7GRzG+L+Xj",
+	"qfthbZTxR60BAnelDlnNzz5e2WwUhSxzpv0d/5+uyIBfQMh0IM1PKzXHeR+u06zHAz9XyGg8lUsiKnzH",
+	"sVj+aH4BsHtXmsZrzuGcJ/FNTNUcORlPasBR9wXZGN5Xa5qYG/gyQi/rZMI9rPBH9IXDM//UEAZembQT",
+	"v2Ix2x1uR5vUO1k63zHwY0qyXFdXMe/4JwVjbWXSUbrZ3tpp763uOlwj1qmUgpfevGlCFdCD5nxnU5bp",
+	"mGWdVmH6HJVKsF2C3mxlAQkX+f0GncR73hySueAEvyCVJSyrwkN1j532Zruz9J6XoHBwJnSxtnLADkDW",
+	"VhbsGJX7qn9bekuL6ZfcTfMe7PKfjr5Ub4IsWkMYgND4lss0m9mafW4JAZM5EBKZR2NCXWN6X5gA3gGD",
+	"88esvzQrCwzpn01B7EZcWlHxMTTlHhFzbGmvoun/D6aXyRT2JJsRwj/VwqnNdGXdJ/KdR076bnERnMVh",
+	"hg+YOjlMbb9mGgF9efDVhjs8OClqkp7oQ4LcWmsukYQW8fnQvJjc0RlRKdHn2RcVdqaLc+kKWbpSi1t9",
+	"BPbLxTCjpcXMyfQw1kaYeljaX8gz+OFIjKnQnglICJ6mkibyebEuqZ3pFp1bacaZUCwmMZN8pJtf/Nd/",
+	"kYvS2gf2vu+/d7Qe+f33XXKoLbOKTeDuGBEr5kN0Myljqk2H8zbRF4Q8e3cyxybslGsy5mFs/uaagZ/r",
+	"ZTmyNy7rIM8qLqcUFgQXRnvOqvbWWqEyWBOeRJnIgciZ8IgJXZrSGA33pzQaM7KFpAgz14qUs7u7uzbF",
+	"x5gmYb6VG8e9g6PTy6PWVrvTHqtJ4qSXBnPQKnBoZukhfgiDdMoEnfKgG2y3O+0dE6CAuL8xp75+97dg",
+	"xJSP9qJpAFF3SkdcIPQSrsO5vNKxdNNRCscNWKujarne0FRBBpimd0Vt7lSzmFT0Ykzxk8pT1FNXIyvS",
+	"Wrs/1Re+lnUjgBsedANU9YPC9uuo427T8gZvaUY+YIi8UwZHX1qVGnqo9W46YnMmntB7bQsA+lWZu3Ar",
+	"b3pTlMvg/E6n42jsm76g3mbyeoIWY++hes6vSuOl2eTdmGVamW/XxFhSpl9z6c0wC5y+RD64NIsar3Eq",
+	"S7c3bRToXrhDDEdt43sr7MxW4/bi2ViHi63WF39Ridu192zv3dxtliEZnk3+oA3BSDVpon8jP0/Yz867",
+	"jFC/qbkOocm824BrXHbWPoiVBELryvIfOPDD+zAoBA4YbqvTsczd+OtNuh1AEXPX4LdHnw7aZ1GMqDkR",
+	"c3TEQQCbXQ5Q753O5rxJilVvXAuAKjjJWaw/2l7+0Zs0G2DxNvhit9NZ/kVP6CTqS/Tw6MIjsBFTy8DQ",
+	"5zn4BbNM/YZJRCrgKU5ESsPG4rARsMu0Sj9V71CCrwqx67t5fWW+I3VPFgpNMZtMU4z+xykQ8ctiebW6",
+	"fqaoXbWcXRFoSHWhPgg9rJbNI0MMfUGRYqfT8fE1DQIPtixjbGfGcVeHiReKpHe4Dn2tkdSaA23NQJL3",
+	"WudiUr1O49mnvGD6cpUKnkmerN3xzU+/hEZtPM+J2FA1Wdz+ZKZv8NMRIXtRG0uqVwYZpPGMFKqTlhI/",
+	"Hwna6bxa/sV+AurZ7OieSyWfkHAdmP4D/ouDL2+s13pR07mE+Yxeh/i7XGxRrlII/clKFGIJ8zvwLRdc",
+	"Mz5WuOMt8uhBZL1VHyJ/JuTZWf7FaareQDrXE+KNPpb5eBMuV6RMPLp3ABDKuJJavG7gxI9MfWKEWFOU",
+	"Wv56b3iaCobB4G8ZjVnmQ7rOl0Gbh4grYTDGheLajq7o3ELW5rUNfAcn2V4RKU9MbMVXcF9+ZGrRZZkC",
+	"YniiodBGKGsGmUVOOeNVRX/sCcvAnnYOY2uvwovtV3vPS4HPzdQISc2BGlYqqmNUFaqzTkV+G0Vlm8iR",
+	"fSPtUTHTZTIJbiz0fGpCg7VudDdOE6Zdn22y7zqPYQYI6+qbTnVlVJHHNmXjb7RDTxbZ6z+QJI0+OLZb",
+	"t/hCIZ+GaNAB6GHioLavA2T7ol7yDkH4T7sS112qVxm6jXu0bjxKMY4vwkpNppOVLjZYb8RdacM9olyU",
+	"pUZBBNcT+ciixpbPwSpXkWfx/Ft4/n/9TLLtF0I/TcDZI2XbSiUYXU0ywNtaryBSvXyBrzDjT97KjOsV",
+	"d8BRPaETgAk2/xluT2GnPca7VuZpGo2oUSDuIXwqOV4DvPTapJmBClzipLKcPz+n0VTgKSX6jTLNfZp7",
+	"hTqHlJc573SBSQO2IWLjsZrTesz2KINfp1kKmZ3StJrAOfrCMgfnGjhJK8AARDxNuVA/EK4A63nZeC1j",
+	"6GC0fdKdDmwWj6RtY+AMZPxyZUMDY+Ndhxpf2iIZn58mfzQRNGv/I1Fjg5AfR5SfiFDJotX710GEViII",
+	"jyFJXex/jBRpBdOqW+i9KBxbrfdeiFiOMKfFM9PORpdtYPdGRCx7fgIFMwl91iLaD4pZqIR2ZVSRVLB+",
+	"oMmX22paJ3DY1jkVSRLZe1+kust2zMQPpPzbzfgyUUnokfzA3EyFpeZjG/FXgVJhUy6sx32xyHzctOrC",
+	"4Xx6DX01i3Dv0NcA3GsPbm39uSzClfbtfyjrsO5u/rvS6kpfPHNNqvRLS9FFK58vmaz/vjZmOM0npf6m",
+	"vSpbKWDDOy1R6UgnnyPlx6wd572wFoYEVLpseTllEeGiNGHGaZRPmFCaGRQl34F/mA5pXsO23sNnN21/",
+	"UrnM7e2/8nW3xxl/FYZzvdfV78NThCXNj0aq5SUvizz6FnH0WSKOpOdoFkcZVbKClwfizHV+13MDPyqy",
+	"yMZLYeGBeVtIZdHijZe58AvWXrZ+mxMdZbvFrbH4npm1CGCo3h5/L8IEY3N1qKhpRqjX6gXsOL27MW0O",
+	"Yz9uzal3+4UG9nxdAT2PiuNZPXxntUCd5fEzTxI386cOl/kdFaGlEtGXHhVTKEJFoaQihrwvmoWH+uKr",
+	"DqPxiHL1TML1g2WQpOxXftLVCqTiSULGVBaiZFHWalCEqYQkFwmWPRmmmXY3YgNtcDKOeTQmEZUMtaFy",
+	"EJoVn7vltbjqC1cJwsaXNCrrti2J4/koJWeeEWg/kalZrK+fOGt0ebTd4bAwx/Yu+BRkRGP4SQ8zn6Ej",
+	"BNdj5OvHGXnDiyqhGf9qadDGrZ6bxzCvi4TbE71xpnUIWZFNMqUd7xZr6hW1XSgs7Rj28PA5qcLjLCWP",
+	"cNIe+F2oeCW33cjZEj8nQE8BuAhX7GISuF7VV6VXtTJ65ZaXEPa1/vp4L+tV3fBVmV0XJrPEBKiNZOpT",
+	"B5o9Or5sjbCyJ6JPf5YwsqWyy7eosc8RNfYkwWL1GDHiDRE7TRUzTTvL6kHaX9UwOGBbz4VVXJc4x5/i",
+	"tn3e0KTf0Qm+9C5+Ec7uajTF1xp5s4ISsMEjDXgvS8P5dKhJPk1SGrOYwAe+ruVodY15xiKFVk/gddcX",
+	"xyvEUEc6wf9JruDCC4AVFje+r+JZITIW9RLqdgGvVFKBR4AsZMsnE2iI2NJE1xfHRRMivW2HZR2nZSVf",
+	"z/r87S8/vzz7aW9wQ+KLU2ZCBu/RuZChDCjSAvBPyO/suTTxe5Wr1KzQsoLzJElwVl0ECSs6qLSIqqgE",
+	"ZYBJjlaLR4faFQgmPh2dibgSEpmaotxYDFDHcxRjJmkExd76YsCGqc0v1cl0K2fGVG9wo9zTZ7nNj0e+",
+	"xno9eFi+Q/RhGiHz6xD8TPeTGgQecyu6xu0wP17qJNU6kz8FFK6DdYy0yb4eLC69IX0xzRiWP409FdgL",
+	"VVFXXx8jcHXHWXCgSJ1bIFICH7LMm27qw3+zjk8hRH4BIlzhKfqqbCVcak/bD9rBNi8j2YS+u940n83E",
+	"Dbs0BkquKrWEPpPFxFhGtLkUF8NVfRN4I56QhJjr8QhqwaTiE6oWkIsD7YHVUql93d/fymN31Q5T+HXE",
+	"b5moRlqVnahNRzCv4dYw17647mlsAO9q8Rkjhq3aiMw2OU0VdmHhsl5UqUpUjsxmfi/V9NEE5VzXkFor",
+	"2u8JKVsqlQWdl7QBZpSI8qUE9gVfnSFapMXFKvpZrGpxLr/9XUzOzvRPRyUt0moaY+nXyqFZDdppSrnN",
+	"J50XudBkE5Dfle+oiG3OpKkMXkRnN8hkX2BioFRN2qojFXWEjfbUOlGLoe283BeGCqLOIWZIHNHqdy2Z",
+	"6SWGNNXUuMskYfc0UsnMNJIZsxka/UzNvHR+nKMhTN8I6kcuxHdPzovCgfaQvxHWz6WiWdh/DLHQoePL",
+	"1TIdfebW16mrZ4WkHJIJ/YCSjuoLSKsDm4RK0cihLzJQCHpLeWLNFaWY5Lu+eo1/Ui3rjxS8/7RqFtWR",
+	"MCXi/KGVqOIcn1qLMui/XIvqsnswAb6m0Qep6GiR3RFotSSQSForJkjhrhcjFM+YUFzNSosi7YvypTJn",
+	"hmJSK5eKmRr1YOjGIRNj1NbOuzwbUozXMqVa7TRGNCAjrLuQxqxt+xFZgCHekEmeKN6yOQ/kf/ZPjolU",
+	"GaMTHMJWducKS7v3hR0ftojdmT5wgTnoF0U3laLqaFv3QGCFsd6NgYZviyIVmDxXNBCrBC8aUcq8iHis",
+	"2zuTSupdZTgMlKK6zuw4TeLmuFhUIoezw4Ga1czBoTjWOcNc6bKz1mla/4xOecs88+mgVUyqRd+vQTdn",
+	"dJJUSENAp9xUK++SgZ0AVmQLp/YFnE63OJu+sCDqwvoBbl1iCQn8guDtEt3/990J/OTAEj+aD616nX13",
+	"YB+wuqRcJ6ALDg+oAZ3T4G8sktiFL/uLcwca5Ka8UYi73B8yJv94kcwam+ol/SRpbthH1RKd/mGC8+cS",
+	"tWM05BYkDV6faU/KYNYEInHkEt1DiULCv5OFW8/g4AKLsgANzJQeFVCxTa71HH0hVZqxYZYK7fnEIuaE",
+	"kkGW3kkUdBS9T0U6ma2Q5OJs+NPKKQinr6wwZpnqofFtzGiixnMx6y0+JtGYRR9QWJ1TBlqX5AH8Aan8",
+	"b2+uj49D2wVAMy8KsaG6pIR1p9vSRkWiOuRbDxHVTD8X7JfvMqKMJUgbxByXnF7vsjD8Y3bLEkBvLao5",
+	"HQtwrdDy1tSTVyl5vX/ZO6gnwcAG5wSmAgBWrqOr1/tOK5ifUiw3gPEhugEtl0Qjw6yGRC4OaKTRze3n",
+	"Is2bPElaChLO9IuERlkq9am7IoAMIQsjtDn+NrapL8yOZb1vlqcIfZtcmGZZWFWKig+GImW2kXuz4bcP",
+	"d3Rz72WIc+l09a/gg21+Pwcn/hPUrRcugjgNBLd29+odBJcmT31ZOX9mni8s6+9TXq1GI/11uMkKZP41",
+	"jS8+exTXEzEgc2GWXGJLVdw2hY/Lup3XvcJNwLVdJuznqCJgTCbeFbwFcp6c4raYeNJkXAj2HyjKhdGi",
+	"ixedhnxl4zqqu5akuSy51txUiU+f0Nvui55uylrktIVlUxaVks1OZ/76fp+832NGb9m8DvDY1NaRCxaQ",
+	"TnaPuas35Uh/2PTSej/Pb4SsKklXMGTVjNQ5JOmpk1N7Gld7h0VlFF975DvIUrM9kkkq2Py0VgcZHpvW",
+	"2jv094/ui5NcKpP/RQ5PL1ubm1vbWg40EXzkWZLesQwT4tDmYHt3opI6nk3HTMjnet/phCs1vw+0KBJO",
+	"VkjA/yOk01aau37edNrG1H5dAnH9i0yndVy+TH/7laXIuhfRI3cVraThiE2K7Kq5Xu7QS3O9FpKXJQzx",
+	"0l3inyPXa52L9S3X61PlejUvh9MQ0nsNXqOlkwvNtZzOkFkusKKqx2DWtZ6JsC9GRU/Q0FhNi76EZQfP",
+	"OVfondNM81PFiRdtHZvh4fqRu/caTP1vmKa69qrrznTgZtgo28e9f/j/AwDxGNoqn/gAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -7,6 +7,35 @@ import (
 	"time"
 )
 
+// Defines values for CatalogItemIconMediaType.
+const (
+	Imagejpeg CatalogItemIconMediaType = "image/jpeg"
+	Imagepng  CatalogItemIconMediaType = "image/png"
+	Imagewebp CatalogItemIconMediaType = "image/webp"
+)
+
+// Defines values for CatalogItemInstancePhase.
+const (
+	FAILED       CatalogItemInstancePhase = "FAILED"
+	PENDING      CatalogItemInstancePhase = "PENDING"
+	PROVISIONING CatalogItemInstancePhase = "PROVISIONING"
+	READY        CatalogItemInstancePhase = "READY"
+)
+
+// Defines values for CatalogItemState.
+const (
+	ARCHIVED  CatalogItemState = "ARCHIVED"
+	DRAFT     CatalogItemState = "DRAFT"
+	PUBLISHED CatalogItemState = "PUBLISHED"
+)
+
+// Defines values for ConditionStatus.
+const (
+	ConditionFalse   ConditionStatus = "False"
+	ConditionTrue    ConditionStatus = "True"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
 // Defines values for ErrorType.
 const (
 	ABORTED            ErrorType = "ABORTED"
@@ -24,19 +53,58 @@ const (
 	UNIMPLEMENTED      ErrorType = "UNIMPLEMENTED"
 )
 
+// Defines values for HealthView.
+const (
+	HealthViewBasic HealthView = "BASIC"
+	HealthViewFull  HealthView = "FULL"
+)
+
+// Defines values for PricingPeriod.
+const (
+	HOUR  PricingPeriod = "HOUR"
+	MONTH PricingPeriod = "MONTH"
+)
+
+// Defines values for SearchResultResourceType.
+const (
+	SearchResultResourceTypeCatalogItem SearchResultResourceType = "CatalogItem"
+	SearchResultResourceTypeServiceType SearchResultResourceType = "ServiceType"
+)
+
 // CatalogItem defines model for CatalogItem.
 type CatalogItem struct {
 	// ApiVersion Version of the CatalogItem schema itself (e.g., v1alpha1).
 	// Immutable after creation.
 	ApiVersion string `json:"api_version"`
 
+	// Categories Categories the catalog item is listed under in the storefront,
+	// e.g. compute or databases. Lowercase words separated by dashes.
+	Categories *[]string `json:"categories,omitempty"`
+
 	// CreateTime Timestamp when the catalog item was created (RFC 3339)
 	CreateTime *time.Time `json:"create_time,omitempty"`
 
+	// CreatedBy Authenticated principal that created the resource, i.e. the
+	// common name of its client certificate.
+	CreatedBy *string `json:"created_by,omitempty"`
+
+	// Description Long-form documentation of what users are ordering, in
+	// CommonMark. Raw HTML is not allowed: HTML tags, and links or
+	// images whose URL scheme is not http, https or mailto, are
+	// removed when the catalog item is stored.
+	Description *string `json:"description,omitempty"`
+
 	// DisplayName User-friendly display name for the catalog item.
 	// Mutable and does not need to be unique.
 	DisplayName string `json:"display_name"`
 
+	// Icon Icon shown for the catalog item in storefronts: either the URL of an
+	// image, or a small uploaded image. Exactly one of url and data must
+	// be set. Uploaded images are served by GET
+	// /catalog-items/{catalogItemId}/icon and are not returned in the
+	// catalog item itself.
+	Icon *CatalogItemIcon `json:"icon,omitempty"`
+
 	// Path Resource path in the format: catalog-items/{catalogItemId}
 	Path *string `json:"path,omitempty"`
 
@@ -44,6 +112,15 @@ type CatalogItem struct {
 	// and field configurations.
 	Spec CatalogItemSpec `json:"spec"`
 
+	// State Lifecycle state of a catalog item. This field is output-only: new
+	// catalog items are DRAFT, and the state changes through the :publish
+	// and :archive methods.
+	// - DRAFT items are only visible to editors and accept no instances
+	// - PUBLISHED items are visible to all and can be ordered
+	// - ARCHIVED items are preserved but hidden from lists and accept no
+	//   new instances; they can be published again
+	State *CatalogItemState `json:"state,omitempty"`
+
 	// Uid Unique identifier for the catalog item. This field is output-only and
 	// immutable after creation. The ID can be optionally specified via
 	// query parameter on creation; if not provided, the server generates a UUID.
@@ -53,8 +130,37 @@ type CatalogItem struct {
 
 	// UpdateTime Timestamp when the catalog item was last modified (RFC 3339)
 	UpdateTime *time.Time `json:"update_time,omitempty"`
+
+	// UpdatedBy Authenticated principal that last modified the resource, i.e. the
+	// common name of its client certificate.
+	UpdatedBy *string `json:"updated_by,omitempty"`
+
+	// Warnings Non-fatal advisory messages about the request, such as deprecated
+	// fields being used or defaults being applied.
+	// Only returned on create, update and preview responses; never persisted.
+	Warnings *Warnings `json:"warnings,omitempty"`
+}
+
+// CatalogItemIcon Icon shown for the catalog item in storefronts: either the URL of an
+// image, or a small uploaded image. Exactly one of url and data must
+// be set. Uploaded images are served by GET
+// /catalog-items/{catalogItemId}/icon and are not returned in the
+// catalog item itself.
+type CatalogItemIcon struct {
+	// Data Base64 encoded image, of at most 64 KiB once decoded. Its
+	// content must match media_type.
+	Data *[]byte `json:"data,omitempty"`
+
+	// MediaType Media type of data; required when data is set
+	MediaType *CatalogItemIconMediaType `json:"media_type,omitempty"`
+
+	// Url HTTPS URL of the icon
+	Url *string `json:"url,omitempty"`
 }
 
+// CatalogItemIconMediaType Media type of data; required when data is set
+type CatalogItemIconMediaType string
+
 // CatalogItemInstance defines model for CatalogItemInstance.
 type CatalogItemInstance struct {
 	// ApiVersion Version of the CatalogItemInstance schema itself (e.g., v1alpha1).
@@ -64,10 +170,26 @@ type CatalogItemInstance struct {
 	// CreateTime Timestamp when the catalog item was created (RFC 3339)
 	CreateTime *time.Time `json:"create_time,omitempty"`
 
+	// CreatedBy Authenticated principal that created the resource, i.e. the
+	// common name of its client certificate.
+	CreatedBy *string `json:"created_by,omitempty"`
+
 	// DisplayName User-friendly display name for the catalog item instance.
 	// Mutable and does not need to be unique.
 	DisplayName string `json:"display_name"`
 
+	// ExpireTime Time after which the instance is deprovisioned and deleted
+	// (RFC 3339), for sandbox and demo environments. It may be set on
+	// creation; otherwise it is derived from the catalog item's
+	// instance_ttl, if any. Instances without it never expire.
+	ExpireTime *time.Time `json:"expire_time,omitempty"`
+	Metadata   *struct {
+		// Labels Key-value pairs for categorization and filtering.
+		// Labels selected by the catalog item's propagated_labels are
+		// propagated to the provisioned workloads.
+		Labels *map[string]string `json:"labels,omitempty"`
+	} `json:"metadata,omitempty"`
+
 	// Path Resource path in the format: catalog-item-instances/{catalogItemInstanceId}
 	Path *string `json:"path,omitempty"`
 
@@ -82,6 +204,10 @@ type CatalogItemInstance struct {
 	// and field configurations.
 	Spec CatalogItemInstanceSpec `json:"spec"`
 
+	// Status Observed provisioning state of a catalog item instance.
+	// Ignored on create and update; set through the status subresource.
+	Status *CatalogItemInstanceStatus `json:"status,omitempty"`
+
 	// Uid Unique identifier for the catalog item instance. This field is output-only and
 	// immutable after creation. The ID can be optionally specified via
 	// query parameter on creation; if not provided, the server generates a UUID.
@@ -91,6 +217,39 @@ type CatalogItemInstance struct {
 
 	// UpdateTime Timestamp when the catalog item was last modified (RFC 3339)
 	UpdateTime *time.Time `json:"update_time,omitempty"`
+
+	// UpdatedBy Authenticated principal that last modified the resource, i.e. the
+	// common name of its client certificate.
+	UpdatedBy *string `json:"updated_by,omitempty"`
+
+	// Warnings Non-fatal advisory messages about the request, such as deprecated
+	// fields being used or defaults being applied.
+	// Only returned on create, update and preview responses; never persisted.
+	Warnings *Warnings `json:"warnings,omitempty"`
+}
+
+// CatalogItemInstanceCloneRequest Overrides applied to the clone of a catalog item instance. An empty
+// object clones the instance unchanged.
+type CatalogItemInstanceCloneRequest struct {
+	// DisplayName Display name of the new instance. Defaults to the display name
+	// of the cloned instance.
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// UserValues User values replacing those of the cloned instance with the same
+	// path. The other user values are copied unchanged.
+	UserValues *[]UserValue `json:"user_values,omitempty"`
+}
+
+// CatalogItemInstanceDescription Aggregated view of a catalog item instance and the resources it was
+// ordered from.
+type CatalogItemInstanceDescription struct {
+	CatalogItem *CatalogItem        `json:"catalog_item,omitempty"`
+	Instance    CatalogItemInstance `json:"instance"`
+
+	// RenderedSpec The service type spec rendered for the instance, as sent to the
+	// provisioner.
+	RenderedSpec *map[string]interface{} `json:"rendered_spec,omitempty"`
+	ServiceType  *ServiceType            `json:"service_type,omitempty"`
 }
 
 // CatalogItemInstanceList defines model for CatalogItemInstanceList.
@@ -103,6 +262,9 @@ type CatalogItemInstanceList struct {
 	Results []CatalogItemInstance `json:"results"`
 }
 
+// CatalogItemInstancePhase Provisioning phase of a catalog item instance.
+type CatalogItemInstancePhase string
+
 // CatalogItemInstanceSpec Specification for a catalog item instance, defining the catalog item reference
 // and field configurations.
 type CatalogItemInstanceSpec struct {
@@ -114,6 +276,23 @@ type CatalogItemInstanceSpec struct {
 	UserValues []UserValue `json:"user_values"`
 }
 
+// CatalogItemInstanceStatus Observed provisioning state of a catalog item instance.
+// Ignored on create and update; set through the status subresource.
+type CatalogItemInstanceStatus struct {
+	// Conditions Detailed conditions reported by the provisioning controller.
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// ExternalReferences References to the resources created for this instance
+	// in external systems.
+	ExternalReferences *[]ExternalReference `json:"external_references,omitempty"`
+
+	// Message Human-readable message describing the current phase.
+	Message *string `json:"message,omitempty"`
+
+	// Phase Provisioning phase of a catalog item instance.
+	Phase CatalogItemInstancePhase `json:"phase"`
+}
+
 // CatalogItemList defines model for CatalogItemList.
 type CatalogItemList struct {
 	// NextPageToken Token for retrieving the next page.
@@ -124,6 +303,26 @@ type CatalogItemList struct {
 	Results []CatalogItem `json:"results"`
 }
 
+// CatalogItemPreview Result of previewing a catalog item instance.
+type CatalogItemPreview struct {
+	// Spec The rendered service type spec, with catalog item defaults and
+	// user values merged in, and the propagated instance labels under
+	// the `dcm_labels` key.
+	Spec map[string]interface{} `json:"spec"`
+
+	// Warnings Non-fatal advisory messages about the request, such as deprecated
+	// fields being used or defaults being applied.
+	// Only returned on create, update and preview responses; never persisted.
+	Warnings *Warnings `json:"warnings,omitempty"`
+}
+
+// CatalogItemPreviewRequest User values to preview against a catalog item.
+type CatalogItemPreviewRequest struct {
+	// UserValues Array of user values, as they would be sent when creating
+	// a catalog item instance.
+	UserValues []UserValue `json:"user_values"`
+}
+
 // CatalogItemSpec Specification for a catalog item, defining the service type reference
 // and field configurations.
 type CatalogItemSpec struct {
@@ -132,11 +331,101 @@ type CatalogItemSpec struct {
 	// in the service type specification.
 	Fields []FieldConfiguration `json:"fields"`
 
+	// InstanceTtl Lifetime of the instances of this catalog item, in seconds. When
+	// set, the expire_time of new instances defaults to their creation
+	// time plus this duration.
+	InstanceTtl *string `json:"instance_ttl,omitempty"`
+
+	// Pricing Pricing metadata of a catalog item. The cost of an instance is
+	// base_cost plus, for each unit cost, the value of its field times its
+	// cost per unit, over one period.
+	Pricing *Pricing `json:"pricing,omitempty"`
+
+	// PropagatedLabels Keys of the instance labels that are propagated to the provisioned
+	// workloads (e.g. VM tags, Kubernetes labels), for traceability back
+	// to the order. The selected labels are included in the rendered
+	// spec under the well-known `dcm_labels` key, for provisioner
+	// drivers to apply.
+	PropagatedLabels *[]string `json:"propagated_labels,omitempty"`
+
 	// ServiceType The Service type this catalog item references.
 	// Immutable after creation.
 	ServiceType string `json:"service_type"`
 }
 
+// CatalogItemState Lifecycle state of a catalog item. This field is output-only: new
+// catalog items are DRAFT, and the state changes through the :publish
+// and :archive methods.
+//   - DRAFT items are only visible to editors and accept no instances
+//   - PUBLISHED items are visible to all and can be ordered
+//   - ARCHIVED items are preserved but hidden from lists and accept no
+//     new instances; they can be published again
+type CatalogItemState string
+
+// Category defines model for Category.
+type Category struct {
+	// CatalogItemCount Number of catalog items in the category
+	CatalogItemCount int32 `json:"catalog_item_count"`
+
+	// Name Name of the category
+	Name string `json:"name"`
+}
+
+// CategoryList defines model for CategoryList.
+type CategoryList struct {
+	// Results Categories with their number of catalog items
+	Results []Category `json:"results"`
+}
+
+// Condition defines model for Condition.
+type Condition struct {
+	// LastTransitionTime Timestamp of the last status change (RFC 3339)
+	LastTransitionTime *time.Time `json:"last_transition_time,omitempty"`
+
+	// Message Human-readable details about the last transition
+	Message *string `json:"message,omitempty"`
+
+	// Reason Machine-readable reason for the last transition
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Status of the condition
+	Status ConditionStatus `json:"status"`
+
+	// Type Type of the condition
+	Type string `json:"type"`
+}
+
+// ConditionStatus Status of the condition
+type ConditionStatus string
+
+// CostEstimate Estimated cost of an instance of a catalog item, for the given user
+// values merged with the catalog item defaults.
+type CostEstimate struct {
+	// Breakdown Cost of each unit cost, in the order of the pricing
+	Breakdown []CostEstimateItem `json:"breakdown"`
+
+	// Currency ISO 4217 currency code
+	Currency string `json:"currency"`
+
+	// Period Period that costs apply to
+	Period PricingPeriod `json:"period"`
+
+	// Total Estimated cost per period
+	Total float64 `json:"total"`
+}
+
+// CostEstimateItem defines model for CostEstimateItem.
+type CostEstimateItem struct {
+	// Cost Cost of the field per period
+	Cost float64 `json:"cost"`
+
+	// Path Path of the priced field
+	Path string `json:"path"`
+
+	// Quantity Value of the field
+	Quantity float64 `json:"quantity"`
+}
+
 // Error Error response following RFC 7807 Problem Details for HTTP APIs
 // and AEP-193 Error Responses specification.
 type Error struct {
@@ -144,6 +433,10 @@ type Error struct {
 	// May contain request-specific details to help debug the issue.
 	Detail *string `json:"detail,omitempty"`
 
+	// FieldViolations Fields of the request that are invalid, when the error concerns
+	// specific fields.
+	FieldViolations *[]FieldViolation `json:"field_violations,omitempty"`
+
 	// Instance Unique identifier for this specific error occurrence.
 	// Can be used for tracking and debugging.
 	Instance *string `json:"instance,omitempty"`
@@ -162,6 +455,18 @@ type Error struct {
 // ErrorType Machine-readable error code. Uses AEP standard error codes.
 type ErrorType string
 
+// ExternalReference defines model for ExternalReference.
+type ExternalReference struct {
+	// Id Identifier of the resource in the external system
+	Id string `json:"id"`
+
+	// Kind Kind of the external resource
+	Kind string `json:"kind"`
+
+	// Url Link to the resource in the external system
+	Url *string `json:"url,omitempty"`
+}
+
 // FieldConfiguration defines model for FieldConfiguration.
 type FieldConfiguration struct {
 	// Default Default value for this field.
@@ -191,13 +496,120 @@ type FieldConfiguration struct {
 	ValidationSchema *map[string]interface{} `json:"validation_schema,omitempty"`
 }
 
+// FieldValidation defines model for FieldValidation.
+type FieldValidation struct {
+	// Default Default value for this field
+	Default interface{} `json:"default"`
+
+	// DisplayName User-facing label for this field
+	DisplayName *string `json:"display_name,omitempty"`
+
+	// Editable Whether users may provide a value for this field.
+	// Values for non-editable fields are rejected.
+	Editable bool `json:"editable"`
+
+	// Path JSON path to the field using dot notation
+	Path string `json:"path"`
+
+	// Required Whether users must provide a value for this field
+	// (editable and without a default value).
+	Required bool `json:"required"`
+
+	// ValidationSchema JSON Schema (draft 2020-12) the value must satisfy.
+	ValidationSchema *map[string]interface{} `json:"validation_schema,omitempty"`
+}
+
+// FieldViolation defines model for FieldViolation.
+type FieldViolation struct {
+	// Description Why the field is invalid
+	Description string `json:"description"`
+
+	// Field Path of the invalid field
+	Field string `json:"field"`
+}
+
 // Health defines model for Health.
 type Health struct {
+	// Components Health of each dependency of the service.
+	// Only returned with view=FULL.
+	Components *[]HealthComponent `json:"components,omitempty"`
+
 	// Path Canonical path of the resource
 	Path *string `json:"path,omitempty"`
 
 	// Status Health status
 	Status string `json:"status"`
+
+	// UptimeSeconds Time since the service started, in seconds.
+	// Only returned with view=FULL.
+	UptimeSeconds *int64 `json:"uptime_seconds,omitempty"`
+}
+
+// HealthComponent defines model for HealthComponent.
+type HealthComponent struct {
+	// LatencyMs Round-trip time of the check, in milliseconds
+	LatencyMs *float64 `json:"latency_ms,omitempty"`
+
+	// Message Reason the dependency is unhealthy
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the dependency
+	Name string `json:"name"`
+
+	// Status Health status of the dependency
+	Status string `json:"status"`
+}
+
+// HealthView Level of detail of a health response
+type HealthView string
+
+// Pricing Pricing metadata of a catalog item. The cost of an instance is
+// base_cost plus, for each unit cost, the value of its field times its
+// cost per unit, over one period.
+type Pricing struct {
+	// BaseCost Fixed cost of an instance per period
+	BaseCost *float64 `json:"base_cost,omitempty"`
+
+	// Currency ISO 4217 currency code
+	Currency string `json:"currency"`
+
+	// Period Period that costs apply to
+	Period PricingPeriod `json:"period"`
+
+	// UnitCosts Costs proportional to the value of numeric fields
+	UnitCosts *[]UnitCost `json:"unit_costs,omitempty"`
+}
+
+// PricingPeriod Period that costs apply to
+type PricingPeriod string
+
+// SearchResult A resource matching a search query.
+type SearchResult struct {
+	// DisplayName Display name of the matching resource
+	DisplayName string `json:"display_name"`
+
+	// Path Resource path of the matching resource
+	Path string `json:"path"`
+
+	// ResourceType Kind of the matching resource
+	ResourceType SearchResultResourceType `json:"resource_type"`
+
+	// Score Relevance of the match; higher is better. Only meaningful for
+	// ordering the results of a single query.
+	Score float64 `json:"score"`
+}
+
+// SearchResultResourceType Kind of the matching resource
+type SearchResultResourceType string
+
+// SearchResultList defines model for SearchResultList.
+type SearchResultList struct {
+	// NextPageToken Token for retrieving the next page.
+	// Empty string indicates this is the last page.
+	NextPageToken string `json:"next_page_token"`
+
+	// Results Matching resources, best match first
+	Results []SearchResult `json:"results"`
 }
 
 // ServiceType defines model for ServiceType.
@@ -208,7 +620,25 @@ type ServiceType struct {
 
 	// CreateTime Timestamp when the resource was created (RFC 3339)
 	CreateTime *time.Time `json:"create_time,omitempty"`
-	Metadata   *struct {
+
+	// CreatedBy Authenticated principal that created the resource, i.e. the
+	// common name of its client certificate.
+	CreatedBy *string `json:"created_by,omitempty"`
+
+	// Deprecated Whether the service type is deprecated. Deprecated service types
+	// remain readable, along with their catalog items, but new catalog
+	// items cannot reference them.
+	Deprecated *bool `json:"deprecated,omitempty"`
+
+	// Deprecation Details shown to the users of a deprecated service type.
+	Deprecation *struct {
+		// Message Why the service type is deprecated and what to do instead
+		Message *string `json:"message,omitempty"`
+
+		// Replacement Path of the service type replacing this one
+		Replacement *string `json:"replacement,omitempty"`
+	} `json:"deprecation,omitempty"`
+	Metadata *struct {
 		// Labels Key-value pairs for categorization and filtering.
 		// Both keys and values are strings.
 		Labels *map[string]string `json:"labels,omitempty"`
@@ -244,6 +674,15 @@ type ServiceType struct {
 
 	// UpdateTime Timestamp when the resource was last modified (RFC 3339)
 	UpdateTime *time.Time `json:"update_time,omitempty"`
+
+	// UpdatedBy Authenticated principal that last modified the resource, i.e. the
+	// common name of its client certificate.
+	UpdatedBy *string `json:"updated_by,omitempty"`
+
+	// Warnings Non-fatal advisory messages about the request, such as deprecated
+	// fields being used or defaults being applied.
+	// Only returned on create, update and preview responses; never persisted.
+	Warnings *Warnings `json:"warnings,omitempty"`
 }
 
 // ServiceTypeList defines model for ServiceTypeList.
@@ -258,6 +697,16 @@ type ServiceTypeList struct {
 	Results []ServiceType `json:"results"`
 }
 
+// UnitCost defines model for UnitCost.
+type UnitCost struct {
+	// Cost Cost per unit of the field value, per period
+	Cost float64 `json:"cost"`
+
+	// Path Path of a numeric field of the service type spec, as in field
+	// configurations.
+	Path string `json:"path"`
+}
+
 // UserValue defines model for UserValue.
 type UserValue struct {
 	// Path JSON path to the user value in the CatalogItem spec using dot notation.
@@ -269,12 +718,56 @@ type UserValue struct {
 	Value interface{} `json:"value"`
 }
 
+// ValidationBundle Validation rules for the user values of a catalog item instance.
+type ValidationBundle struct {
+	// Fields Validation rules, one entry per field configuration
+	Fields []FieldValidation `json:"fields"`
+
+	// Path Resource path in the format: catalog-items/{catalogItemId}/validation-bundle
+	Path *string `json:"path,omitempty"`
+
+	// ServiceType The service type of the catalog item
+	ServiceType string `json:"service_type"`
+}
+
+// Version defines model for Version.
+type Version struct {
+	// BuildDate Time the binary was built (RFC 3339)
+	BuildDate string `json:"build_date"`
+
+	// GitCommit Git commit the binary was built from
+	GitCommit string `json:"git_commit"`
+
+	// GoVersion Go runtime version the binary was built with
+	GoVersion string `json:"go_version"`
+
+	// Path Canonical path of the resource
+	Path *string `json:"path,omitempty"`
+
+	// Platform Operating system and architecture of the binary
+	Platform string `json:"platform"`
+
+	// Version Release version of the service
+	Version string `json:"version"`
+}
+
+// Warnings Non-fatal advisory messages about the request, such as deprecated
+// fields being used or defaults being applied.
+// Only returned on create, update and preview responses; never persisted.
+type Warnings = []string
+
 // CatalogItemIdPath defines model for CatalogItemIdPath.
 type CatalogItemIdPath = string
 
 // CatalogItemInstanceIdPath defines model for CatalogItemInstanceIdPath.
 type CatalogItemInstanceIdPath = string
 
+// FieldsQuery defines model for FieldsQuery.
+type FieldsQuery = []string
+
+// IfNoneMatchHeader defines model for IfNoneMatchHeader.
+type IfNoneMatchHeader = string
+
 // ServiceTypeIdPath defines model for ServiceTypeIdPath.
 type ServiceTypeIdPath = string
 
@@ -313,6 +806,22 @@ type ListCatalogItemInstancesParams struct {
 	// CatalogItemId Filter catalog item instances by catalog item ID.
 	// Only returns items where spec.catalog_item_id matches this value.
 	CatalogItemId *string `form:"catalog_item_id,omitempty" json:"catalog_item_id,omitempty"`
+
+	// Phase Filter catalog item instances by provisioning phase.
+	// Only returns items where status.phase matches this value.
+	Phase *CatalogItemInstancePhase `form:"phase,omitempty" json:"phase,omitempty"`
+
+	// Owner Filter catalog item instances by owner.
+	// Only returns items where created_by matches this value; the
+	// special value `me` matches the authenticated principal.
+	Owner *string `form:"owner,omitempty" json:"owner,omitempty"`
+
+	// Fields Comma-separated paths of the fields to return, as in
+	// `fields=uid,display_name,spec.service_type`. Other fields are left
+	// out of the response. On list operations the paths apply to each
+	// resource in `results`. Unknown paths are ignored. All fields are
+	// returned when not specified.
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // CreateCatalogItemInstanceParams defines parameters for CreateCatalogItemInstance.
@@ -321,6 +830,27 @@ type CreateCatalogItemInstanceParams struct {
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
 }
 
+// GetCatalogItemInstanceParams defines parameters for GetCatalogItemInstance.
+type GetCatalogItemInstanceParams struct {
+	// Fields Comma-separated paths of the fields to return, as in
+	// `fields=uid,display_name,spec.service_type`. Other fields are left
+	// out of the response. On list operations the paths apply to each
+	// resource in `results`. Unknown paths are ignored. All fields are
+	// returned when not specified.
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// IfNoneMatch ETags of the representations the client already has. When one of
+	// them matches the current representation, 304 Not Modified is
+	// returned without a body.
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
+// CloneCatalogItemInstanceParams defines parameters for CloneCatalogItemInstance.
+type CloneCatalogItemInstanceParams struct {
+	// Id Optional user-specified ID of the new instance
+	Id *string `form:"id,omitempty" json:"id,omitempty"`
+}
+
 // ListCatalogItemsParams defines parameters for ListCatalogItems.
 type ListCatalogItemsParams struct {
 	// PageToken Token for retrieving the next page of results
@@ -332,6 +862,21 @@ type ListCatalogItemsParams struct {
 	// ServiceType Filter catalog items by service type.
 	// Only returns items where spec.service_type matches this value.
 	ServiceType *string `form:"service_type,omitempty" json:"service_type,omitempty"`
+
+	// Category Filter catalog items by category.
+	// Only returns items whose categories include this value.
+	Category *string `form:"category,omitempty" json:"category,omitempty"`
+
+	// ShowArchived Include ARCHIVED catalog items. DRAFT items are only listed for
+	// editors.
+	ShowArchived *bool `form:"show_archived,omitempty" json:"show_archived,omitempty"`
+
+	// Fields Comma-separated paths of the fields to return, as in
+	// `fields=uid,display_name,spec.service_type`. Other fields are left
+	// out of the response. On list operations the paths apply to each
+	// resource in `results`. Unknown paths are ignored. All fields are
+	// returned when not specified.
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // CreateCatalogItemParams defines parameters for CreateCatalogItem.
@@ -340,6 +885,46 @@ type CreateCatalogItemParams struct {
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
 }
 
+// DeleteCatalogItemParams defines parameters for DeleteCatalogItem.
+type DeleteCatalogItemParams struct {
+	// Force Also delete the instances of the catalog item, following AEP-135
+	// cascading delete.
+	Force *bool `form:"force,omitempty" json:"force,omitempty"`
+}
+
+// GetCatalogItemParams defines parameters for GetCatalogItem.
+type GetCatalogItemParams struct {
+	// Fields Comma-separated paths of the fields to return, as in
+	// `fields=uid,display_name,spec.service_type`. Other fields are left
+	// out of the response. On list operations the paths apply to each
+	// resource in `results`. Unknown paths are ignored. All fields are
+	// returned when not specified.
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// IfNoneMatch ETags of the representations the client already has. When one of
+	// them matches the current representation, 304 Not Modified is
+	// returned without a body.
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
+// GetHealthParams defines parameters for GetHealth.
+type GetHealthParams struct {
+	// View Level of detail of the response. Defaults to BASIC.
+	View *HealthView `form:"view,omitempty" json:"view,omitempty"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	// Q Search query
+	Q string `form:"q" json:"q"`
+
+	// PageToken Token for retrieving the next page of results
+	PageToken *string `form:"page_token,omitempty" json:"page_token,omitempty"`
+
+	// MaxPageSize Maximum number of results to return per page
+	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+}
+
 // ListServiceTypesParams defines parameters for ListServiceTypes.
 type ListServiceTypesParams struct {
 	// PageToken Token for retrieving the next page of results.
@@ -349,6 +934,16 @@ type ListServiceTypesParams struct {
 	// MaxPageSize Maximum number of items to return per page.
 	// If not specified, defaults to 100.
 	MaxPageSize *int32 `form:"max_page_size,omitempty" json:"max_page_size,omitempty"`
+
+	// ExcludeDeprecated Leave deprecated service types out of the results
+	ExcludeDeprecated *bool `form:"exclude_deprecated,omitempty" json:"exclude_deprecated,omitempty"`
+
+	// Fields Comma-separated paths of the fields to return, as in
+	// `fields=uid,display_name,spec.service_type`. Other fields are left
+	// out of the response. On list operations the paths apply to each
+	// resource in `results`. Unknown paths are ignored. All fields are
+	// returned when not specified.
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
 }
 
 // CreateServiceTypeParams defines parameters for CreateServiceType.
@@ -359,14 +954,44 @@ type CreateServiceTypeParams struct {
 	Id *string `form:"id,omitempty" json:"id,omitempty"`
 }
 
+// GetServiceTypeParams defines parameters for GetServiceType.
+type GetServiceTypeParams struct {
+	// Fields Comma-separated paths of the fields to return, as in
+	// `fields=uid,display_name,spec.service_type`. Other fields are left
+	// out of the response. On list operations the paths apply to each
+	// resource in `results`. Unknown paths are ignored. All fields are
+	// returned when not specified.
+	Fields *FieldsQuery `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// IfNoneMatch ETags of the representations the client already has. When one of
+	// them matches the current representation, 304 Not Modified is
+	// returned without a body.
+	IfNoneMatch *IfNoneMatchHeader `json:"If-None-Match,omitempty"`
+}
+
 // CreateCatalogItemInstanceJSONRequestBody defines body for CreateCatalogItemInstance for application/json ContentType.
 type CreateCatalogItemInstanceJSONRequestBody = CatalogItemInstance
 
+// UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody defines body for UpdateCatalogItemInstance for application/merge-patch+json ContentType.
+type UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody = CatalogItemInstance
+
+// UpdateCatalogItemInstanceStatusJSONRequestBody defines body for UpdateCatalogItemInstanceStatus for application/json ContentType.
+type UpdateCatalogItemInstanceStatusJSONRequestBody = CatalogItemInstanceStatus
+
+// CloneCatalogItemInstanceJSONRequestBody defines body for CloneCatalogItemInstance for application/json ContentType.
+type CloneCatalogItemInstanceJSONRequestBody = CatalogItemInstanceCloneRequest
+
 // CreateCatalogItemJSONRequestBody defines body for CreateCatalogItem for application/json ContentType.
 type CreateCatalogItemJSONRequestBody = CatalogItem
 
 // UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody defines body for UpdateCatalogItem for application/merge-patch+json ContentType.
 type UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody = CatalogItem
 
+// EstimateCatalogItemJSONRequestBody defines body for EstimateCatalogItem for application/json ContentType.
+type EstimateCatalogItemJSONRequestBody = CatalogItemPreviewRequest
+
+// PreviewCatalogItemJSONRequestBody defines body for PreviewCatalogItem for application/json ContentType.
+type PreviewCatalogItemJSONRequestBody = CatalogItemPreviewRequest
+
 // CreateServiceTypeJSONRequestBody defines body for CreateServiceType for application/json ContentType.
 type CreateServiceTypeJSONRequestBody = ServiceType
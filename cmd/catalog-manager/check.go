@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/spf13/cobra"
+)
+
+// newCheckCommand returns the check command, which validates the
+// configuration without serving, e.g. before rolling it out.
+func newCheckCommand(configFile *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Validate the configuration and exit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, _, err := loadConfig(*configFile)
+			if err != nil {
+				return err
+			}
+			tlsConfig, err := apiserver.NewTLSConfig(cfg)
+			if err != nil {
+				return err
+			}
+			if err := apiserver.ValidateConfig(cfg, tlsConfig); err != nil {
+				return err
+			}
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), "Configuration is valid")
+			return err
+		},
+	}
+}
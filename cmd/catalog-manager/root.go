@@ -0,0 +1,60 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+	"github.com/spf13/cobra"
+)
+
+// newRootCommand returns the catalog-manager command. Without a subcommand
+// it serves the API, as it did before subcommands were added.
+func newRootCommand() *cobra.Command {
+	var configFile string
+
+	root := &cobra.Command{
+		Use:   "catalog-manager",
+		Short: "DCM catalog manager",
+		Args:  cobra.NoArgs,
+		// Errors are logged by main
+		SilenceErrors: true,
+		SilenceUsage:  true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serve(configFile)
+		},
+	}
+	root.PersistentFlags().StringVar(&configFile, "config", "",
+		"Path to a YAML configuration file; environment variables take precedence")
+
+	root.AddCommand(
+		newServeCommand(&configFile),
+		newCheckCommand(&configFile),
+		newVersionCommand(),
+	)
+	return root
+}
+
+// loadConfig loads the configuration and sets up the default logger from
+// it. The returned level can be changed afterwards, e.g. on reload.
+func loadConfig(configFile string) (*config.Config, *slog.LevelVar, error) {
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load configuration: %w", err)
+	}
+
+	logLevel := new(slog.LevelVar)
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to configure logging: %w", err)
+	}
+	logLevel.Set(level)
+	logger, err := logging.New(os.Stderr, cfg.LogFormat, logLevel)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to configure logging: %w", err)
+	}
+	slog.SetDefault(logger)
+	return cfg, logLevel, nil
+}
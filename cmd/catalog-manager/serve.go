@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/health"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+	"github.com/spf13/cobra"
+)
+
+func newServeCommand(configFile *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "serve",
+		Short: "Serve the API until interrupted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return serve(*configFile)
+		},
+	}
+}
+
+func serve(configFile string) error {
+	cfg, logLevel, err := loadConfig(configFile)
+	if err != nil {
+		return err
+	}
+
+	// Create TCP listener
+	listener, err := net.Listen("tcp", cfg.BindAddress)
+	if err != nil {
+		return fmt.Errorf("failed to create listener: %w", err)
+	}
+	defer listener.Close()
+
+	// Dependencies register their readiness checks here
+	readiness := health.NewChecker(cfg.ReadinessTimeout)
+
+	// Each API version and integration registers its group of routes here
+	routes := apiserver.NewRoutingTable(
+		apiserver.V1Alpha1(v1alpha1.NewHandler(readiness)),
+	)
+
+	srv := apiserver.New(cfg, listener, routes, readiness)
+
+	// Create context with signal handling
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	// Run the debug endpoints on their own listener, if enabled
+	if cfg.AdminBindAddress != "" {
+		adminListener, err := net.Listen("tcp", cfg.AdminBindAddress)
+		if err != nil {
+			return fmt.Errorf("failed to create admin listener: %w", err)
+		}
+		defer adminListener.Close()
+
+		go func() {
+			if err := apiserver.NewAdmin(adminListener, readiness).Run(ctx); err != nil {
+				slog.Error("Admin server failed", "error", err)
+				cancel()
+			}
+		}()
+	}
+
+	// Reload the configuration on SIGHUP
+	reload := make(chan os.Signal, 1)
+	signal.Notify(reload, syscall.SIGHUP)
+	defer signal.Stop(reload)
+	go func() {
+		for range reload {
+			if err := reloadConfig(configFile, logLevel, srv); err != nil {
+				slog.Error("Failed to reload configuration, keeping the current one", "error", err)
+				continue
+			}
+			slog.Info("Reloaded configuration")
+		}
+	}()
+
+	// Create and run server
+	if err := srv.Run(ctx); err != nil {
+		return fmt.Errorf("server failed: %w", err)
+	}
+	return nil
+}
+
+// reloadConfig loads the configuration again and applies the settings that
+// can change without restarting: the log level, and those of the API server.
+func reloadConfig(configFile string, logLevel *slog.LevelVar, srv *apiserver.Server) error {
+	cfg, err := config.Load(configFile)
+	if err != nil {
+		return err
+	}
+	level, err := logging.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		return err
+	}
+	if err := srv.Reload(cfg); err != nil {
+		return err
+	}
+	logLevel.Set(level)
+	return nil
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/internal/version"
+	"github.com/spf13/cobra"
+)
+
+func newVersionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "version",
+		Short: "Print the version information",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			_, err := fmt.Fprintf(cmd.OutOrStdout(),
+				"Version:    %s\nGit commit: %s\nBuild date: %s\nGo version: %s\nPlatform:   %s\n",
+				version.Version, version.GitCommit, version.BuildDate, version.GoVersion(), version.Platform())
+			return err
+		},
+	}
+}
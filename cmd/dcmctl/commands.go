@@ -0,0 +1,282 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// outputOnlyFields are the fields set by the server, removed from manifests
+// so that the output of get can be applied again.
+var outputOnlyFields = []string{
+	"uid", "path", "create_time", "update_time", "created_by", "updated_by", "warnings",
+	"service_type_instance_uid", "state", "status",
+}
+
+func newGetCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get RESOURCE [ID]",
+		Short: "List resources, or show one by ID",
+		Long:  "List resources, or show one by ID. Resources are " + resourceNames() + ".",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output); err != nil {
+				return err
+			}
+			res, err := lookupResource(args[0])
+			if err != nil {
+				return err
+			}
+			c, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+			ctx := cmd.Context()
+
+			if len(args) == 2 {
+				object, err := decodeResponse(res.get(ctx, c, args[1]))
+				if err != nil {
+					return err
+				}
+				return printObjects(cmd.OutOrStdout(), opts.output, res, []map[string]any{object}, true)
+			}
+
+			objects := []map[string]any{}
+			pageToken := ""
+			for {
+				page, err := decodeResponse(res.list(ctx, c, pageToken))
+				if err != nil {
+					return err
+				}
+				results, _ := page["results"].([]any)
+				for _, result := range results {
+					if object, ok := result.(map[string]any); ok {
+						objects = append(objects, object)
+					}
+				}
+				pageToken, _ = page["next_page_token"].(string)
+				if pageToken == "" {
+					break
+				}
+			}
+			return printObjects(cmd.OutOrStdout(), opts.output, res, objects, false)
+		},
+	}
+}
+
+func newCreateCommand(opts *options) *cobra.Command {
+	var file, id string
+	cmd := &cobra.Command{
+		Use:   "create RESOURCE -f FILE",
+		Short: "Create a resource from a YAML or JSON file",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := validateOutput(opts.output); err != nil {
+				return err
+			}
+			res, err := lookupResource(args[0])
+			if err != nil {
+				return err
+			}
+			manifests, err := readManifests(file, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			if len(manifests) != 1 {
+				return fmt.Errorf("%s must hold exactly one resource, use apply for several", file)
+			}
+			manifest := manifests[0]
+			if manifest.kind != "" && manifest.kind != res.kind {
+				return fmt.Errorf("%s holds a %s, not a %s", file, manifest.kind, res.kind)
+			}
+			if id == "" {
+				id = manifest.id
+			}
+
+			c, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+			object, err := decodeResponse(res.create(cmd.Context(), c, id, bytes.NewReader(manifest.body)))
+			if err != nil {
+				return err
+			}
+			return printObjects(cmd.OutOrStdout(), opts.output, res, []map[string]any{object}, true)
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "File holding the resource, or - for stdin")
+	cmd.Flags().StringVar(&id, "id", "", "ID of the resource; generated by the server when empty")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+func newDeleteCommand(opts *options) *cobra.Command {
+	var force bool
+	cmd := &cobra.Command{
+		Use:   "delete RESOURCE ID",
+		Short: "Delete a resource",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			res, err := lookupResource(args[0])
+			if err != nil {
+				return err
+			}
+			remove := res.remove
+			if force {
+				remove = res.forceRemove
+			}
+			if remove == nil {
+				if force {
+					return fmt.Errorf("%s resources cannot be force deleted", res.names[0])
+				}
+				return fmt.Errorf("%s resources cannot be deleted", res.names[0])
+			}
+			c, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+			resp, err := remove(cmd.Context(), c, args[1])
+			if _, err := decodeResponse(resp, err); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s/%s deleted\n", res.names[0], args[1])
+			if deleted := resp.Header.Get("X-Deleted-Instances"); deleted != "" {
+				fmt.Fprintf(cmd.OutOrStdout(), "%s instances deleted along with it\n", deleted)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().BoolVar(&force, "force", false, "Also delete the instances of a catalog item")
+	return cmd
+}
+
+func newApplyCommand(opts *options) *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "apply -f FILE",
+		Short: "Create or update the resources of a YAML or JSON file",
+		Long: "Create or update the resources of a YAML or JSON file. Each document names its kind, " +
+			"e.g. kind: CatalogItem, and its ID in uid. Resources with an ID are updated when they " +
+			"exist; those without one are always created.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manifests, err := readManifests(file, cmd.InOrStdin())
+			if err != nil {
+				return err
+			}
+			c, err := opts.newClient()
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			for i, manifest := range manifests {
+				if manifest.kind == "" {
+					return fmt.Errorf("document %d of %s does not set its kind", i+1, file)
+				}
+				res, err := lookupKind(manifest.kind)
+				if err != nil {
+					return fmt.Errorf("document %d of %s: %w", i+1, file, err)
+				}
+
+				exists := false
+				if manifest.id != "" {
+					_, err := decodeResponse(res.get(ctx, c, manifest.id))
+					var apiErr *apiError
+					switch {
+					case err == nil:
+						exists = true
+					case errors.As(err, &apiErr) && apiErr.status == http.StatusNotFound:
+					default:
+						return err
+					}
+				}
+
+				var action string
+				if exists {
+					if res.update == nil {
+						return fmt.Errorf("%s/%s already exists and %s resources cannot be updated",
+							res.names[0], manifest.id, res.names[0])
+					}
+					_, err = decodeResponse(res.update(ctx, c, manifest.id, bytes.NewReader(manifest.body)))
+					action = "configured"
+				} else {
+					var object map[string]any
+					object, err = decodeResponse(res.create(ctx, c, manifest.id, bytes.NewReader(manifest.body)))
+					if manifest.id == "" {
+						manifest.id = lookupField(object, "uid")
+					}
+					action = "created"
+				}
+				if err != nil {
+					return err
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "%s/%s %s\n", res.names[0], manifest.id, action)
+			}
+			return nil
+		},
+	}
+	cmd.Flags().StringVarP(&file, "filename", "f", "", "File holding the resources, or - for stdin")
+	_ = cmd.MarkFlagRequired("filename")
+	return cmd
+}
+
+// manifest is a resource read from a file, with its kind and ID taken out
+// of the request body.
+type manifest struct {
+	kind string
+	id   string
+	body []byte
+}
+
+// readManifests reads the YAML documents of path, or of stdin when path is
+// "-". JSON is read as YAML.
+func readManifests(path string, stdin io.Reader) ([]manifest, error) {
+	var content []byte
+	var err error
+	if path == "-" {
+		content, err = io.ReadAll(stdin)
+	} else {
+		content, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var manifests []manifest
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	for {
+		var document map[string]any
+		if err := decoder.Decode(&document); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		if document == nil {
+			continue
+		}
+
+		m := manifest{}
+		m.kind, _ = document["kind"].(string)
+		m.id, _ = document["uid"].(string)
+		delete(document, "kind")
+		for _, field := range outputOnlyFields {
+			delete(document, field)
+		}
+		if m.body, err = json.Marshal(document); err != nil {
+			return nil, fmt.Errorf("failed to encode a resource of %s: %w", path, err)
+		}
+		manifests = append(manifests, m)
+	}
+	if len(manifests) == 0 {
+		return nil, fmt.Errorf("%s holds no resources", path)
+	}
+	return manifests, nil
+}
@@ -0,0 +1,243 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dcm-project/catalog-manager/pkg/client"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// apiPrefix is where the catalog manager serves the API
+const apiPrefix = "/api/v1alpha1/"
+
+// Context is how to reach and authenticate to a catalog manager.
+type Context struct {
+	Server string `yaml:"server"`
+	// CAFile is the CA bundle verifying the server; the system roots are
+	// used when empty
+	CAFile string `yaml:"ca_file,omitempty"`
+	// CertFile and KeyFile are the client certificate presented to servers
+	// requiring one
+	CertFile string `yaml:"cert_file,omitempty"`
+	KeyFile  string `yaml:"key_file,omitempty"`
+}
+
+// Config holds the contexts of dcmctl, one per catalog manager or identity.
+type Config struct {
+	CurrentContext string             `yaml:"current_context,omitempty"`
+	Contexts       map[string]Context `yaml:"contexts,omitempty"`
+}
+
+// defaultConfigFile returns $DCMCTL_CONFIG, or config.yaml in the dcmctl
+// directory of the user configuration directory.
+func defaultConfigFile() string {
+	if path := os.Getenv("DCMCTL_CONFIG"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "dcmctl", "config.yaml")
+}
+
+// loadConfig reads the contexts from path; a missing file has none.
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{Contexts: map[string]Context{}}
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if err := yaml.Unmarshal(content, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if cfg.Contexts == nil {
+		cfg.Contexts = map[string]Context{}
+	}
+	return cfg, nil
+}
+
+func (c *Config) save(path string) error {
+	content, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, content, 0o600)
+}
+
+// resolveContext returns the context selected by the flags, falling back
+// to the current context.
+func (o *options) resolveContext() (Context, error) {
+	cfg, err := loadConfig(o.configFile)
+	if err != nil {
+		return Context{}, err
+	}
+
+	var ctx Context
+	name := o.context
+	if name == "" {
+		name = cfg.CurrentContext
+	}
+	if name != "" {
+		var ok bool
+		if ctx, ok = cfg.Contexts[name]; !ok {
+			return Context{}, fmt.Errorf("context %q not found in %s", name, o.configFile)
+		}
+	}
+	if o.server != "" {
+		ctx.Server = o.server
+	}
+	if ctx.Server == "" {
+		return Context{}, errors.New("no server configured: use --server or dcmctl config set-context")
+	}
+	return ctx, nil
+}
+
+// newClient returns an API client for the selected context.
+func (o *options) newClient() (*client.Client, error) {
+	ctx, err := o.resolveContext()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if ctx.CAFile != "" {
+		bundle, err := os.ReadFile(ctx.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA bundle: %w", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(bundle) {
+			return nil, fmt.Errorf("no certificates found in CA bundle %s", ctx.CAFile)
+		}
+	}
+	if ctx.CertFile != "" || ctx.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(ctx.CertFile, ctx.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+
+	httpClient := &http.Client{
+		Timeout:   o.timeout,
+		Transport: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig},
+	}
+	return client.NewClient(strings.TrimSuffix(ctx.Server, "/")+apiPrefix, client.WithHTTPClient(httpClient))
+}
+
+func newConfigCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Manage the contexts",
+	}
+	cmd.AddCommand(newSetContextCommand(opts), newUseContextCommand(opts), newGetContextsCommand(opts))
+	return cmd
+}
+
+func newSetContextCommand(opts *options) *cobra.Command {
+	var ctx Context
+	cmd := &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Create or update a context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig(opts.configFile)
+			if err != nil {
+				return err
+			}
+			// Only change the settings given on the command line
+			current := cfg.Contexts[args[0]]
+			flags := cmd.Flags()
+			if flags.Changed("server") {
+				current.Server = ctx.Server
+			}
+			if flags.Changed("ca-file") {
+				current.CAFile = ctx.CAFile
+			}
+			if flags.Changed("cert-file") {
+				current.CertFile = ctx.CertFile
+			}
+			if flags.Changed("key-file") {
+				current.KeyFile = ctx.KeyFile
+			}
+			cfg.Contexts[args[0]] = current
+			if cfg.CurrentContext == "" {
+				cfg.CurrentContext = args[0]
+			}
+			return cfg.save(opts.configFile)
+		},
+	}
+	// The global --server flag is shadowed by the one of the context
+	cmd.Flags().StringVar(&ctx.Server, "server", "", "URL of the catalog manager")
+	cmd.Flags().StringVar(&ctx.CAFile, "ca-file", "", "CA bundle verifying the server")
+	cmd.Flags().StringVar(&ctx.CertFile, "cert-file", "", "Client certificate")
+	cmd.Flags().StringVar(&ctx.KeyFile, "key-file", "", "Key of the client certificate")
+	return cmd
+}
+
+func newUseContextCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Set the current context",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig(opts.configFile)
+			if err != nil {
+				return err
+			}
+			if _, ok := cfg.Contexts[args[0]]; !ok {
+				return fmt.Errorf("context %q not found in %s", args[0], opts.configFile)
+			}
+			cfg.CurrentContext = args[0]
+			return cfg.save(opts.configFile)
+		},
+	}
+}
+
+func newGetContextsCommand(opts *options) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-contexts",
+		Short: "List the contexts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig(opts.configFile)
+			if err != nil {
+				return err
+			}
+			names := make([]string, 0, len(cfg.Contexts))
+			for name := range cfg.Contexts {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 4, 3, ' ', 0)
+			fmt.Fprintln(w, "CURRENT\tNAME\tSERVER")
+			for _, name := range names {
+				current := ""
+				if name == cfg.CurrentContext {
+					current = "*"
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", current, name, cfg.Contexts[name].Server)
+			}
+			return w.Flush()
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/importer"
+	"github.com/spf13/cobra"
+)
+
+func newImportCommand(opts *options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Generate catalog items from other formats",
+	}
+	cmd.AddCommand(newImportHelmSchemaCommand(opts))
+	return cmd
+}
+
+func newImportHelmSchemaCommand(opts *options) *cobra.Command {
+	var serviceType, displayName, id string
+	cmd := &cobra.Command{
+		Use:   "helm-schema SOURCE",
+		Short: "Generate a catalog item from the values.schema.json of a Helm chart",
+		Long: "Generate a catalog item from the values.schema.json of a Helm chart, read from a file " +
+			"or an http(s) URL. The manifest is written to stdout, to be reviewed and then applied, " +
+			"e.g. with dcmctl apply -f -.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			content, err := readSource(args[0], opts.timeout)
+			if err != nil {
+				return err
+			}
+			fields, err := importer.FieldsFromHelmSchema(content)
+			if err != nil {
+				return err
+			}
+
+			item := v1alpha1.CatalogItem{
+				ApiVersion:  "v1alpha1",
+				DisplayName: displayName,
+				Spec:        v1alpha1.CatalogItemSpec{ServiceType: serviceType, Fields: fields},
+			}
+			body, err := json.Marshal(item)
+			if err != nil {
+				return err
+			}
+			var manifest map[string]any
+			if err := json.Unmarshal(body, &manifest); err != nil {
+				return err
+			}
+			manifest["kind"] = "CatalogItem"
+			if id != "" {
+				manifest["uid"] = id
+			}
+
+			format := opts.output
+			if format == outputTable {
+				format = outputYAML
+			}
+			return printObjects(cmd.OutOrStdout(), format, nil, []map[string]any{manifest}, true)
+		},
+	}
+	cmd.Flags().StringVar(&serviceType, "service-type", "", "Service type of the catalog item")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the catalog item")
+	cmd.Flags().StringVar(&id, "id", "", "ID of the catalog item")
+	_ = cmd.MarkFlagRequired("service-type")
+	_ = cmd.MarkFlagRequired("display-name")
+	return cmd
+}
+
+// readSource reads a local file, or downloads an http(s) URL.
+func readSource(source string, timeout time.Duration) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		content, err := os.ReadFile(source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		return content, nil
+	}
+
+	httpClient := &http.Client{Timeout: timeout}
+	resp, err := httpClient.Get(source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", source, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", source, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
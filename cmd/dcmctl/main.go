@@ -0,0 +1,54 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func main() {
+	if err := newRootCommand().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		os.Exit(1)
+	}
+}
+
+// options are the global flags of dcmctl.
+type options struct {
+	configFile string
+	context    string
+	server     string
+	output     string
+	timeout    time.Duration
+}
+
+func newRootCommand() *cobra.Command {
+	opts := &options{}
+
+	root := &cobra.Command{
+		Use:   "dcmctl",
+		Short: "Manage the resources of a DCM catalog manager",
+		// Errors are printed by main
+		SilenceErrors: true,
+		SilenceUsage:  true,
+	}
+	flags := root.PersistentFlags()
+	flags.StringVar(&opts.configFile, "dcmconfig", defaultConfigFile(),
+		"Path to the file holding the contexts; defaults to $DCMCTL_CONFIG")
+	flags.StringVar(&opts.context, "context", "", "Context to use instead of the current one")
+	flags.StringVar(&opts.server, "server", "", "URL of the catalog manager, overriding the one of the context")
+	flags.StringVarP(&opts.output, "output", "o", outputTable, "Output format: table, json or yaml")
+	flags.DurationVar(&opts.timeout, "timeout", 30*time.Second, "Timeout of each request")
+
+	root.AddCommand(
+		newGetCommand(opts),
+		newCreateCommand(opts),
+		newDeleteCommand(opts),
+		newApplyCommand(opts),
+		newImportCommand(opts),
+		newConfigCommand(opts),
+	)
+	return root
+}
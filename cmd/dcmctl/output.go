@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// column is a column of the table output, showing the field at path, a
+// dot-separated list of keys.
+type column struct {
+	header string
+	path   string
+}
+
+func validateOutput(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	default:
+		return fmt.Errorf("invalid output format %q: must be table, json or yaml", format)
+	}
+}
+
+// printObjects writes objects in format. A single object is written as is
+// in JSON and YAML, and several as a list.
+func printObjects(w io.Writer, format string, res *resource, objects []map[string]any, single bool) error {
+	var value any = objects
+	if single && len(objects) == 1 {
+		value = objects[0]
+	}
+
+	switch format {
+	case outputJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(value)
+	case outputYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+		return encoder.Close()
+	default:
+		return printTable(w, res.columns, objects)
+	}
+}
+
+func printTable(w io.Writer, columns []column, objects []map[string]any) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 3, ' ', 0)
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = col.header
+	}
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+
+	for _, object := range objects {
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			cells[i] = lookupField(object, col.path)
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+// lookupField returns the field of object at path, or "" when missing.
+func lookupField(object map[string]any, path string) string {
+	var value any = object
+	for _, key := range strings.Split(path, ".") {
+		fields, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+		if value, ok = fields[key]; !ok {
+			return ""
+		}
+	}
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
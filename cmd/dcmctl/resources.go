@@ -0,0 +1,194 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+// mergePatchContentType is the content type of partial updates
+const mergePatchContentType = "application/merge-patch+json"
+
+// resource describes how to manage one kind of resource through the API.
+// update, remove and forceRemove are nil when the API does not support them.
+type resource struct {
+	// kind names the resource in manifests, e.g. CatalogItem
+	kind string
+	// names are accepted on the command line; the first one is used in
+	// messages
+	names   []string
+	columns []column
+
+	list   func(ctx context.Context, c *client.Client, pageToken string) (*http.Response, error)
+	get    func(ctx context.Context, c *client.Client, id string) (*http.Response, error)
+	create func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error)
+	update func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error)
+	remove func(ctx context.Context, c *client.Client, id string) (*http.Response, error)
+	// forceRemove also deletes the dependent resources, reporting their
+	// number in the X-Deleted-Instances header
+	forceRemove func(ctx context.Context, c *client.Client, id string) (*http.Response, error)
+}
+
+var resources = []*resource{
+	{
+		kind:  "ServiceType",
+		names: []string{"service-type", "service-types", "st"},
+		columns: []column{
+			{"UID", "uid"}, {"SERVICE TYPE", "service_type"}, {"API VERSION", "api_version"}, {"DEPRECATED", "deprecated"},
+			{"CREATED", "create_time"},
+		},
+		list: func(ctx context.Context, c *client.Client, pageToken string) (*http.Response, error) {
+			return c.ListServiceTypes(ctx, &v1alpha1.ListServiceTypesParams{PageToken: optional(pageToken)})
+		},
+		get: func(ctx context.Context, c *client.Client, id string) (*http.Response, error) {
+			return c.GetServiceType(ctx, id, nil)
+		},
+		create: func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error) {
+			return c.CreateServiceTypeWithBody(ctx, &v1alpha1.CreateServiceTypeParams{Id: optional(id)}, "application/json", body)
+		},
+	},
+	{
+		kind:  "CatalogItem",
+		names: []string{"catalog-item", "catalog-items", "ci"},
+		columns: []column{
+			{"UID", "uid"}, {"DISPLAY NAME", "display_name"}, {"SERVICE TYPE", "spec.service_type"}, {"STATE", "state"},
+			{"CREATED", "create_time"},
+		},
+		list: func(ctx context.Context, c *client.Client, pageToken string) (*http.Response, error) {
+			return c.ListCatalogItems(ctx, &v1alpha1.ListCatalogItemsParams{PageToken: optional(pageToken)})
+		},
+		get: func(ctx context.Context, c *client.Client, id string) (*http.Response, error) {
+			return c.GetCatalogItem(ctx, id, nil)
+		},
+		create: func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error) {
+			return c.CreateCatalogItemWithBody(ctx, &v1alpha1.CreateCatalogItemParams{Id: optional(id)}, "application/json", body)
+		},
+		update: func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error) {
+			return c.UpdateCatalogItemWithBody(ctx, id, mergePatchContentType, body)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) (*http.Response, error) {
+			return c.DeleteCatalogItem(ctx, id, nil)
+		},
+		forceRemove: func(ctx context.Context, c *client.Client, id string) (*http.Response, error) {
+			force := true
+			return c.DeleteCatalogItem(ctx, id, &v1alpha1.DeleteCatalogItemParams{Force: &force})
+		},
+	},
+	{
+		kind:  "CatalogItemInstance",
+		names: []string{"catalog-item-instance", "catalog-item-instances", "instance", "instances", "cii"},
+		columns: []column{
+			{"UID", "uid"}, {"DISPLAY NAME", "display_name"}, {"CATALOG ITEM", "spec.catalog_item_id"},
+			{"PHASE", "status.phase"}, {"CREATED", "create_time"},
+		},
+		list: func(ctx context.Context, c *client.Client, pageToken string) (*http.Response, error) {
+			return c.ListCatalogItemInstances(ctx, &v1alpha1.ListCatalogItemInstancesParams{PageToken: optional(pageToken)})
+		},
+		get: func(ctx context.Context, c *client.Client, id string) (*http.Response, error) {
+			return c.GetCatalogItemInstance(ctx, id, nil)
+		},
+		create: func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error) {
+			return c.CreateCatalogItemInstanceWithBody(ctx, &v1alpha1.CreateCatalogItemInstanceParams{Id: optional(id)}, "application/json", body)
+		},
+		update: func(ctx context.Context, c *client.Client, id string, body io.Reader) (*http.Response, error) {
+			return c.UpdateCatalogItemInstanceWithBody(ctx, id, mergePatchContentType, body)
+		},
+		remove: func(ctx context.Context, c *client.Client, id string) (*http.Response, error) {
+			return c.DeleteCatalogItemInstance(ctx, id)
+		},
+	},
+}
+
+// lookupResource returns the resource named on the command line.
+func lookupResource(name string) (*resource, error) {
+	for _, res := range resources {
+		if slices.Contains(res.names, strings.ToLower(name)) {
+			return res, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown resource %q; known resources: %s", name, resourceNames())
+}
+
+// lookupKind returns the resource of the kind named in a manifest.
+func lookupKind(kind string) (*resource, error) {
+	for _, res := range resources {
+		if res.kind == kind {
+			return res, nil
+		}
+	}
+	kinds := make([]string, len(resources))
+	for i, res := range resources {
+		kinds[i] = res.kind
+	}
+	return nil, fmt.Errorf("unknown kind %q; known kinds: %s", kind, strings.Join(kinds, ", "))
+}
+
+func resourceNames() string {
+	names := make([]string, len(resources))
+	for i, res := range resources {
+		names[i] = res.names[0]
+	}
+	return strings.Join(names, ", ")
+}
+
+// apiError is a problem response of the API.
+type apiError struct {
+	status int
+	title  string
+	detail string
+}
+
+func (e *apiError) Error() string {
+	if e.detail == "" {
+		return fmt.Sprintf("%s (%d)", e.title, e.status)
+	}
+	return fmt.Sprintf("%s: %s (%d)", e.title, e.detail, e.status)
+}
+
+// decodeResponse returns the JSON body of a successful response, or the
+// problem of a failed one as an *apiError.
+func decodeResponse(resp *http.Response, err error) (map[string]any, error) {
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		apiErr := &apiError{status: resp.StatusCode, title: http.StatusText(resp.StatusCode)}
+		var problem v1alpha1.Error
+		if json.Unmarshal(body, &problem) == nil && problem.Title != "" {
+			apiErr.title = problem.Title
+			if problem.Detail != nil {
+				apiErr.detail = *problem.Detail
+			}
+		}
+		return nil, apiErr
+	}
+
+	if len(body) == 0 {
+		return nil, nil
+	}
+	var object map[string]any
+	if err := json.Unmarshal(body, &object); err != nil {
+		return nil, fmt.Errorf("failed to decode the response: %w", err)
+	}
+	return object, nil
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	. "github.com/dcm-project/catalog-manager/api/v1alpha1"
@@ -28,7 +29,19 @@ type ServerInterface interface {
 	DeleteCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
 	// Get a catalog item instance
 	// (GET /catalog-item-instances/{catalogItemInstanceId})
-	GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
+	GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params GetCatalogItemInstanceParams)
+	// Update a catalog item instance
+	// (PATCH /catalog-item-instances/{catalogItemInstanceId})
+	UpdateCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
+	// Update the status of a catalog item instance
+	// (PUT /catalog-item-instances/{catalogItemInstanceId}/status)
+	UpdateCatalogItemInstanceStatus(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
+	// Clone a catalog item instance
+	// (POST /catalog-item-instances/{catalogItemInstanceId}:clone)
+	CloneCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params CloneCatalogItemInstanceParams)
+	// Describe a catalog item instance
+	// (GET /catalog-item-instances/{catalogItemInstanceId}:describe)
+	DescribeCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath)
 	// List catalog items
 	// (GET /catalog-items)
 	ListCatalogItems(w http.ResponseWriter, r *http.Request, params ListCatalogItemsParams)
@@ -37,16 +50,43 @@ type ServerInterface interface {
 	CreateCatalogItem(w http.ResponseWriter, r *http.Request, params CreateCatalogItemParams)
 	// Delete a catalog item
 	// (DELETE /catalog-items/{catalogItemId})
-	DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params DeleteCatalogItemParams)
 	// Get a catalog item
 	// (GET /catalog-items/{catalogItemId})
-	GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params GetCatalogItemParams)
 	// Update a catalog item
 	// (PATCH /catalog-items/{catalogItemId})
 	UpdateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Get the icon of a catalog item
+	// (GET /catalog-items/{catalogItemId}/icon)
+	GetCatalogItemIcon(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Get the validation bundle of a catalog item
+	// (GET /catalog-items/{catalogItemId}/validation-bundle)
+	GetCatalogItemValidationBundle(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Archive a catalog item
+	// (POST /catalog-items/{catalogItemId}:archive)
+	ArchiveCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Estimate the cost of a catalog item instance
+	// (POST /catalog-items/{catalogItemId}:estimate)
+	EstimateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Preview a catalog item instance
+	// (POST /catalog-items/{catalogItemId}:preview)
+	PreviewCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Publish a catalog item
+	// (POST /catalog-items/{catalogItemId}:publish)
+	PublishCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath)
+	// Export catalog items as Backstage entities
+	// (GET /catalog-items:exportBackstage)
+	ExportBackstageCatalogItems(w http.ResponseWriter, r *http.Request)
+	// List catalog item categories
+	// (GET /catalog-items:listCategories)
+	ListCatalogItemCategories(w http.ResponseWriter, r *http.Request)
 	// Health check
 	// (GET /health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
+	GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams)
+	// Search service types and catalog items
+	// (GET /search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
 	// List service types
 	// (GET /service-types)
 	ListServiceTypes(w http.ResponseWriter, r *http.Request, params ListServiceTypesParams)
@@ -55,7 +95,10 @@ type ServerInterface interface {
 	CreateServiceType(w http.ResponseWriter, r *http.Request, params CreateServiceTypeParams)
 	// Get a service type
 	// (GET /service-types/{serviceTypeId})
-	GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath)
+	GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params GetServiceTypeParams)
+	// Version information
+	// (GET /version)
+	GetVersion(w http.ResponseWriter, r *http.Request)
 }
 
 // Unimplemented server implementation that returns http.StatusNotImplemented for each endpoint.
@@ -82,7 +125,31 @@ func (_ Unimplemented) DeleteCatalogItemInstance(w http.ResponseWriter, r *http.
 
 // Get a catalog item instance
 // (GET /catalog-item-instances/{catalogItemInstanceId})
-func (_ Unimplemented) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+func (_ Unimplemented) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params GetCatalogItemInstanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update a catalog item instance
+// (PATCH /catalog-item-instances/{catalogItemInstanceId})
+func (_ Unimplemented) UpdateCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Update the status of a catalog item instance
+// (PUT /catalog-item-instances/{catalogItemInstanceId}/status)
+func (_ Unimplemented) UpdateCatalogItemInstanceStatus(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Clone a catalog item instance
+// (POST /catalog-item-instances/{catalogItemInstanceId}:clone)
+func (_ Unimplemented) CloneCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params CloneCatalogItemInstanceParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Describe a catalog item instance
+// (GET /catalog-item-instances/{catalogItemInstanceId}:describe)
+func (_ Unimplemented) DescribeCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -100,13 +167,13 @@ func (_ Unimplemented) CreateCatalogItem(w http.ResponseWriter, r *http.Request,
 
 // Delete a catalog item
 // (DELETE /catalog-items/{catalogItemId})
-func (_ Unimplemented) DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+func (_ Unimplemented) DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params DeleteCatalogItemParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
 // Get a catalog item
 // (GET /catalog-items/{catalogItemId})
-func (_ Unimplemented) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+func (_ Unimplemented) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params GetCatalogItemParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -116,9 +183,63 @@ func (_ Unimplemented) UpdateCatalogItem(w http.ResponseWriter, r *http.Request,
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// Get the icon of a catalog item
+// (GET /catalog-items/{catalogItemId}/icon)
+func (_ Unimplemented) GetCatalogItemIcon(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Get the validation bundle of a catalog item
+// (GET /catalog-items/{catalogItemId}/validation-bundle)
+func (_ Unimplemented) GetCatalogItemValidationBundle(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Archive a catalog item
+// (POST /catalog-items/{catalogItemId}:archive)
+func (_ Unimplemented) ArchiveCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Estimate the cost of a catalog item instance
+// (POST /catalog-items/{catalogItemId}:estimate)
+func (_ Unimplemented) EstimateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Preview a catalog item instance
+// (POST /catalog-items/{catalogItemId}:preview)
+func (_ Unimplemented) PreviewCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Publish a catalog item
+// (POST /catalog-items/{catalogItemId}:publish)
+func (_ Unimplemented) PublishCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Export catalog items as Backstage entities
+// (GET /catalog-items:exportBackstage)
+func (_ Unimplemented) ExportBackstageCatalogItems(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// List catalog item categories
+// (GET /catalog-items:listCategories)
+func (_ Unimplemented) ListCatalogItemCategories(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // Health check
 // (GET /health)
-func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request) {
+func (_ Unimplemented) GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Search service types and catalog items
+// (GET /search)
+func (_ Unimplemented) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -136,7 +257,13 @@ func (_ Unimplemented) CreateServiceType(w http.ResponseWriter, r *http.Request,
 
 // Get a service type
 // (GET /service-types/{serviceTypeId})
-func (_ Unimplemented) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath) {
+func (_ Unimplemented) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params GetServiceTypeParams) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// Version information
+// (GET /version)
+func (_ Unimplemented) GetVersion(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
@@ -181,6 +308,30 @@ func (siw *ServerInterfaceWrapper) ListCatalogItemInstances(w http.ResponseWrite
 		return
 	}
 
+	// ------------- Optional query parameter "phase" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "phase", r.URL.Query(), &params.Phase)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "phase", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "owner" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "owner", r.URL.Query(), &params.Owner)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "owner", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListCatalogItemInstances(w, r, params)
 	}))
@@ -258,8 +409,151 @@ func (siw *ServerInterfaceWrapper) GetCatalogItemInstance(w http.ResponseWriter,
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogItemInstanceParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCatalogItemInstance(w, r, catalogItemInstanceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCatalogItemInstance operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCatalogItemInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemInstanceId" -------------
+	var catalogItemInstanceId CatalogItemInstanceIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemInstanceId", chi.URLParam(r, "catalogItemInstanceId"), &catalogItemInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemInstanceId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCatalogItemInstance(w, r, catalogItemInstanceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCatalogItemInstanceStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCatalogItemInstanceStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemInstanceId" -------------
+	var catalogItemInstanceId CatalogItemInstanceIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemInstanceId", chi.URLParam(r, "catalogItemInstanceId"), &catalogItemInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemInstanceId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCatalogItemInstanceStatus(w, r, catalogItemInstanceId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CloneCatalogItemInstance operation middleware
+func (siw *ServerInterfaceWrapper) CloneCatalogItemInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemInstanceId" -------------
+	var catalogItemInstanceId CatalogItemInstanceIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemInstanceId", chi.URLParam(r, "catalogItemInstanceId"), &catalogItemInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemInstanceId", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CloneCatalogItemInstanceParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CloneCatalogItemInstance(w, r, catalogItemInstanceId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DescribeCatalogItemInstance operation middleware
+func (siw *ServerInterfaceWrapper) DescribeCatalogItemInstance(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemInstanceId" -------------
+	var catalogItemInstanceId CatalogItemInstanceIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemInstanceId", chi.URLParam(r, "catalogItemInstanceId"), &catalogItemInstanceId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemInstanceId", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCatalogItemInstance(w, r, catalogItemInstanceId)
+		siw.Handler.DescribeCatalogItemInstance(w, r, catalogItemInstanceId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -301,6 +595,30 @@ func (siw *ServerInterfaceWrapper) ListCatalogItems(w http.ResponseWriter, r *ht
 		return
 	}
 
+	// ------------- Optional query parameter "category" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "category", r.URL.Query(), &params.Category)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "category", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "show_archived" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "show_archived", r.URL.Query(), &params.ShowArchived)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "show_archived", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.ListCatalogItems(w, r, params)
 	}))
@@ -353,8 +671,19 @@ func (siw *ServerInterfaceWrapper) DeleteCatalogItem(w http.ResponseWriter, r *h
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCatalogItemParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "force", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCatalogItem(w, r, catalogItemId)
+		siw.Handler.DeleteCatalogItem(w, r, catalogItemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -378,8 +707,40 @@ func (siw *ServerInterfaceWrapper) GetCatalogItem(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCatalogItemParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCatalogItem(w, r, catalogItemId)
+		siw.Handler.GetCatalogItem(w, r, catalogItemId, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -414,11 +775,22 @@ func (siw *ServerInterfaceWrapper) UpdateCatalogItem(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+// GetCatalogItemIcon operation middleware
+func (siw *ServerInterfaceWrapper) GetCatalogItemIcon(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
+		siw.Handler.GetCatalogItemIcon(w, r, catalogItemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -428,32 +800,22 @@ func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListServiceTypes operation middleware
-func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *http.Request) {
+// GetCatalogItemValidationBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetCatalogItemValidationBundle(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListServiceTypesParams
-
-	// ------------- Optional query parameter "page_token" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "max_page_size" -------------
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListServiceTypes(w, r, params)
+		siw.Handler.GetCatalogItemValidationBundle(w, r, catalogItemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -463,24 +825,22 @@ func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
-// CreateServiceType operation middleware
-func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *http.Request) {
+// ArchiveCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) ArchiveCatalogItem(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params CreateServiceTypeParams
-
-	// ------------- Optional query parameter "id" -------------
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
 
-	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateServiceType(w, r, params)
+		siw.Handler.ArchiveCatalogItem(w, r, catalogItemId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -490,8 +850,266 @@ func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
-// GetServiceType operation middleware
-func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http.Request) {
+// EstimateCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) EstimateCatalogItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EstimateCatalogItem(w, r, catalogItemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreviewCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) PreviewCatalogItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewCatalogItem(w, r, catalogItemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PublishCatalogItem operation middleware
+func (siw *ServerInterfaceWrapper) PublishCatalogItem(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "catalogItemId" -------------
+	var catalogItemId CatalogItemIdPath
+
+	err = runtime.BindStyledParameterWithOptions("simple", "catalogItemId", chi.URLParam(r, "catalogItemId"), &catalogItemId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "catalogItemId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PublishCatalogItem(w, r, catalogItemId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ExportBackstageCatalogItems operation middleware
+func (siw *ServerInterfaceWrapper) ExportBackstageCatalogItems(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ExportBackstageCatalogItems(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListCatalogItemCategories operation middleware
+func (siw *ServerInterfaceWrapper) ListCatalogItemCategories(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListCatalogItemCategories(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHealthParams
+
+	// ------------- Optional query parameter "view" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view", r.URL.Query(), &params.View)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListServiceTypes operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceTypes(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListServiceTypesParams
+
+	// ------------- Optional query parameter "page_token" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_token", r.URL.Query(), &params.PageToken)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_token", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_page_size", r.URL.Query(), &params.MaxPageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_page_size", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_deprecated" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_deprecated", r.URL.Query(), &params.ExcludeDeprecated)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_deprecated", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListServiceTypes(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateServiceType operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceType(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateServiceTypeParams
+
+	// ------------- Optional query parameter "id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "id", r.URL.Query(), &params.Id)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceType(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetServiceType operation middleware
+func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
@@ -504,8 +1122,54 @@ func (siw *ServerInterfaceWrapper) GetServiceType(w http.ResponseWriter, r *http
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetServiceTypeParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", false, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-None-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-None-Match")]; found {
+		var IfNoneMatch IfNoneMatchHeader
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-None-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-None-Match", valueList[0], &IfNoneMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-None-Match", Err: err})
+			return
+		}
+
+		params.IfNoneMatch = &IfNoneMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetServiceType(w, r, serviceTypeId, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetVersion operation middleware
+func (siw *ServerInterfaceWrapper) GetVersion(w http.ResponseWriter, r *http.Request) {
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetServiceType(w, r, serviceTypeId)
+		siw.Handler.GetVersion(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -554,652 +1218,1569 @@ type RequiredHeaderError struct {
 	Err       error
 }
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances", wrapper.ListCatalogItemInstances)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-item-instances", wrapper.CreateCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.DeleteCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.GetCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.UpdateCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}/status", wrapper.UpdateCatalogItemInstanceStatus)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}:clone", wrapper.CloneCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}:describe", wrapper.DescribeCatalogItemInstance)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items", wrapper.ListCatalogItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items", wrapper.CreateCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.DeleteCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.GetCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Patch(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.UpdateCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}/icon", wrapper.GetCatalogItemIcon)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}/validation-bundle", wrapper.GetCatalogItemValidationBundle)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:archive", wrapper.ArchiveCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:estimate", wrapper.EstimateCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:preview", wrapper.PreviewCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/catalog-items/{catalogItemId}:publish", wrapper.PublishCatalogItem)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items:exportBackstage", wrapper.ExportBackstageCatalogItems)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/catalog-items:listCategories", wrapper.ListCatalogItemCategories)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/search", wrapper.Search)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/service-types", wrapper.ListServiceTypes)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/service-types", wrapper.CreateServiceType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/service-types/{serviceTypeId}", wrapper.GetServiceType)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/version", wrapper.GetVersion)
+	})
+
+	return r
+}
+
+type AlreadyExistsJSONResponse Error
+
+type BadRequestJSONResponse Error
+
+type ForbiddenJSONResponse Error
+
+type InternalServerErrorJSONResponse Error
+
+type NotFoundJSONResponse Error
+
+type NotModifiedResponseHeaders struct {
+	ETag string
+}
+type NotModifiedResponse struct {
+	Headers NotModifiedResponseHeaders
+}
+
+type UnauthorizedJSONResponse Error
+
+type ListCatalogItemInstancesRequestObject struct {
+	Params ListCatalogItemInstancesParams
+}
+
+type ListCatalogItemInstancesResponseObject interface {
+	VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error
+}
+
+type ListCatalogItemInstances200JSONResponse CatalogItemInstanceList
+
+func (response ListCatalogItemInstances200JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCatalogItemInstances401JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCatalogItemInstances403JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemInstances500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListCatalogItemInstances500JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstanceRequestObject struct {
+	Params CreateCatalogItemInstanceParams
+	Body   *CreateCatalogItemInstanceJSONRequestBody
+}
+
+type CreateCatalogItemInstanceResponseObject interface {
+	VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type CreateCatalogItemInstance201JSONResponse CatalogItemInstance
+
+func (response CreateCatalogItemInstance201JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance400JSONResponse Error
+
+func (response CreateCatalogItemInstance400JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCatalogItemInstance401JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCatalogItemInstance403JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateCatalogItemInstance409JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateCatalogItemInstance500JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+}
+
+type DeleteCatalogItemInstanceResponseObject interface {
+	VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type DeleteCatalogItemInstance204Response struct {
+}
+
+func (response DeleteCatalogItemInstance204Response) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCatalogItemInstance401JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteCatalogItemInstance403JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCatalogItemInstance404JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteCatalogItemInstance500JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	Params                GetCatalogItemInstanceParams
+}
+
+type GetCatalogItemInstanceResponseObject interface {
+	VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogItemInstance200ResponseHeaders struct {
+	ETag string
+}
+
+type GetCatalogItemInstance200JSONResponse struct {
+	Body    CatalogItemInstance
+	Headers GetCatalogItemInstance200ResponseHeaders
+}
+
+func (response GetCatalogItemInstance200JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCatalogItemInstance304Response = NotModifiedResponse
+
+func (response GetCatalogItemInstance304Response) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCatalogItemInstance401JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCatalogItemInstance403JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCatalogItemInstance404JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCatalogItemInstance500JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	Body                  *UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateCatalogItemInstanceResponseObject interface {
+	VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type UpdateCatalogItemInstance200JSONResponse CatalogItemInstance
+
+func (response UpdateCatalogItemInstance200JSONResponse) VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstance400JSONResponse Error
+
+func (response UpdateCatalogItemInstance400JSONResponse) VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateCatalogItemInstance401JSONResponse) VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateCatalogItemInstance403JSONResponse) VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateCatalogItemInstance404JSONResponse) VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateCatalogItemInstance500JSONResponse) VisitUpdateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceStatusRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	Body                  *UpdateCatalogItemInstanceStatusJSONRequestBody
+}
+
+type UpdateCatalogItemInstanceStatusResponseObject interface {
+	VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateCatalogItemInstanceStatus200JSONResponse CatalogItemInstance
+
+func (response UpdateCatalogItemInstanceStatus200JSONResponse) VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceStatus400JSONResponse Error
+
+func (response UpdateCatalogItemInstanceStatus400JSONResponse) VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateCatalogItemInstanceStatus401JSONResponse) VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateCatalogItemInstanceStatus403JSONResponse) VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateCatalogItemInstanceStatus404JSONResponse) VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemInstanceStatus500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response UpdateCatalogItemInstanceStatus500JSONResponse) VisitUpdateCatalogItemInstanceStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+	Params                CloneCatalogItemInstanceParams
+	Body                  *CloneCatalogItemInstanceJSONRequestBody
+}
+
+type CloneCatalogItemInstanceResponseObject interface {
+	VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type CloneCatalogItemInstance201JSONResponse CatalogItemInstance
+
+func (response CloneCatalogItemInstance201JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstance400JSONResponse Error
+
+func (response CloneCatalogItemInstance400JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CloneCatalogItemInstance401JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CloneCatalogItemInstance403JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CloneCatalogItemInstance404JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstance409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CloneCatalogItemInstance409JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CloneCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CloneCatalogItemInstance500JSONResponse) VisitCloneCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DescribeCatalogItemInstanceRequestObject struct {
+	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+}
+
+type DescribeCatalogItemInstanceResponseObject interface {
+	VisitDescribeCatalogItemInstanceResponse(w http.ResponseWriter) error
+}
+
+type DescribeCatalogItemInstance200JSONResponse CatalogItemInstanceDescription
+
+func (response DescribeCatalogItemInstance200JSONResponse) VisitDescribeCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DescribeCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DescribeCatalogItemInstance401JSONResponse) VisitDescribeCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DescribeCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DescribeCatalogItemInstance403JSONResponse) VisitDescribeCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DescribeCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DescribeCatalogItemInstance404JSONResponse) VisitDescribeCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DescribeCatalogItemInstance500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DescribeCatalogItemInstance500JSONResponse) VisitDescribeCatalogItemInstanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItemsRequestObject struct {
+	Params ListCatalogItemsParams
+}
+
+type ListCatalogItemsResponseObject interface {
+	VisitListCatalogItemsResponse(w http.ResponseWriter) error
+}
+
+type ListCatalogItems200JSONResponse CatalogItemList
+
+func (response ListCatalogItems200JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListCatalogItems401JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListCatalogItems403JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListCatalogItems500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response ListCatalogItems500JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItemRequestObject struct {
+	Params CreateCatalogItemParams
+	Body   *CreateCatalogItemJSONRequestBody
+}
+
+type CreateCatalogItemResponseObject interface {
+	VisitCreateCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type CreateCatalogItem201JSONResponse CatalogItem
+
+func (response CreateCatalogItem201JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem400JSONResponse Error
+
+func (response CreateCatalogItem400JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateCatalogItem401JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateCatalogItem403JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem409JSONResponse struct{ AlreadyExistsJSONResponse }
+
+func (response CreateCatalogItem409JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response CreateCatalogItem500JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Params        DeleteCatalogItemParams
+}
+
+type DeleteCatalogItemResponseObject interface {
+	VisitDeleteCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type DeleteCatalogItem204ResponseHeaders struct {
+	XDeletedInstances int32
+}
+
+type DeleteCatalogItem204Response struct {
+	Headers DeleteCatalogItem204ResponseHeaders
+}
+
+func (response DeleteCatalogItem204Response) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("X-Deleted-Instances", fmt.Sprint(response.Headers.XDeletedInstances))
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteCatalogItem401JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteCatalogItem403JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response DeleteCatalogItem404JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem409JSONResponse Error
+
+func (response DeleteCatalogItem409JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response DeleteCatalogItem500JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Params        GetCatalogItemParams
+}
+
+type GetCatalogItemResponseObject interface {
+	VisitGetCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type GetCatalogItem200ResponseHeaders struct {
+	ETag string
+}
+
+type GetCatalogItem200JSONResponse struct {
+	Body    CatalogItem
+	Headers GetCatalogItem200ResponseHeaders
+}
+
+func (response GetCatalogItem200JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetCatalogItem304Response = NotModifiedResponse
+
+func (response GetCatalogItem304Response) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
+}
+
+type GetCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetCatalogItem401JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetCatalogItem403JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetCatalogItem404JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
+}
+
+func (response GetCatalogItem500JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Body          *UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody
+}
+
+type UpdateCatalogItemResponseObject interface {
+	VisitUpdateCatalogItemResponse(w http.ResponseWriter) error
+}
+
+type UpdateCatalogItem200JSONResponse CatalogItem
+
+func (response UpdateCatalogItem200JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem400JSONResponse Error
+
+func (response UpdateCatalogItem400JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateCatalogItem401JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+func (response UpdateCatalogItem403JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+type UpdateCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+func (response UpdateCatalogItem404JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+type UpdateCatalogItem500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{})
-}
+func (response UpdateCatalogItem500JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
 
-type ChiServerOptions struct {
-	BaseURL          string
-	BaseRouter       chi.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	return json.NewEncoder(w).Encode(response)
 }
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseRouter: r,
-	})
+type GetCatalogItemIconRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, ChiServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
+type GetCatalogItemIconResponseObject interface {
+	VisitGetCatalogItemIconResponse(w http.ResponseWriter) error
 }
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
-	r := options.BaseRouter
+type GetCatalogItemIcon200ImageResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
 
-	if r == nil {
-		r = chi.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+func (response GetCatalogItemIcon200ImageResponse) VisitGetCatalogItemIconResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
 	}
+	w.WriteHeader(200)
 
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-item-instances", wrapper.ListCatalogItemInstances)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/catalog-item-instances", wrapper.CreateCatalogItemInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.DeleteCatalogItemInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-item-instances/{catalogItemInstanceId}", wrapper.GetCatalogItemInstance)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-items", wrapper.ListCatalogItems)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/catalog-items", wrapper.CreateCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Delete(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.DeleteCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.GetCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Patch(options.BaseURL+"/catalog-items/{catalogItemId}", wrapper.UpdateCatalogItem)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/health", wrapper.GetHealth)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/service-types", wrapper.ListServiceTypes)
-	})
-	r.Group(func(r chi.Router) {
-		r.Post(options.BaseURL+"/service-types", wrapper.CreateServiceType)
-	})
-	r.Group(func(r chi.Router) {
-		r.Get(options.BaseURL+"/service-types/{serviceTypeId}", wrapper.GetServiceType)
-	})
-
-	return r
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type AlreadyExistsJSONResponse Error
-
-type BadRequestJSONResponse Error
-
-type ForbiddenJSONResponse Error
-
-type InternalServerErrorJSONResponse Error
-
-type NotFoundJSONResponse Error
-
-type UnauthorizedJSONResponse Error
+type GetCatalogItemIcon302ResponseHeaders struct {
+	Location string
+}
 
-type ListCatalogItemInstancesRequestObject struct {
-	Params ListCatalogItemInstancesParams
+type GetCatalogItemIcon302Response struct {
+	Headers GetCatalogItemIcon302ResponseHeaders
 }
 
-type ListCatalogItemInstancesResponseObject interface {
-	VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error
+func (response GetCatalogItemIcon302Response) VisitGetCatalogItemIconResponse(w http.ResponseWriter) error {
+	w.Header().Set("Location", fmt.Sprint(response.Headers.Location))
+	w.WriteHeader(302)
+	return nil
 }
 
-type ListCatalogItemInstances200JSONResponse CatalogItemInstanceList
+type GetCatalogItemIcon401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListCatalogItemInstances200JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemIcon401JSONResponse) VisitGetCatalogItemIconResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItemInstances401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetCatalogItemIcon403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListCatalogItemInstances401JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemIcon403JSONResponse) VisitGetCatalogItemIconResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItemInstances403JSONResponse struct{ ForbiddenJSONResponse }
+type GetCatalogItemIcon404JSONResponse Error
 
-func (response ListCatalogItemInstances403JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemIcon404JSONResponse) VisitGetCatalogItemIconResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItemInstances500JSONResponse struct {
+type GetCatalogItemIcon500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ListCatalogItemInstances500JSONResponse) VisitListCatalogItemInstancesResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemIcon500JSONResponse) VisitGetCatalogItemIconResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItemInstanceRequestObject struct {
-	Params CreateCatalogItemInstanceParams
-	Body   *CreateCatalogItemInstanceJSONRequestBody
-}
-
-type CreateCatalogItemInstanceResponseObject interface {
-	VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error
+type GetCatalogItemValidationBundleRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
 }
 
-type CreateCatalogItemInstance201JSONResponse CatalogItemInstance
-
-func (response CreateCatalogItemInstance201JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
-
-	return json.NewEncoder(w).Encode(response)
+type GetCatalogItemValidationBundleResponseObject interface {
+	VisitGetCatalogItemValidationBundleResponse(w http.ResponseWriter) error
 }
 
-type CreateCatalogItemInstance400JSONResponse Error
+type GetCatalogItemValidationBundle200JSONResponse ValidationBundle
 
-func (response CreateCatalogItemInstance400JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemValidationBundle200JSONResponse) VisitGetCatalogItemValidationBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetCatalogItemValidationBundle401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateCatalogItemInstance401JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemValidationBundle401JSONResponse) VisitGetCatalogItemValidationBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+type GetCatalogItemValidationBundle403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response CreateCatalogItemInstance403JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemValidationBundle403JSONResponse) VisitGetCatalogItemValidationBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItemInstance409JSONResponse struct{ AlreadyExistsJSONResponse }
+type GetCatalogItemValidationBundle404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateCatalogItemInstance409JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemValidationBundle404JSONResponse) VisitGetCatalogItemValidationBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItemInstance500JSONResponse struct {
+type GetCatalogItemValidationBundle500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response CreateCatalogItemInstance500JSONResponse) VisitCreateCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response GetCatalogItemValidationBundle500JSONResponse) VisitGetCatalogItemValidationBundleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItemInstanceRequestObject struct {
-	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+type ArchiveCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
 }
 
-type DeleteCatalogItemInstanceResponseObject interface {
-	VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error
+type ArchiveCatalogItemResponseObject interface {
+	VisitArchiveCatalogItemResponse(w http.ResponseWriter) error
 }
 
-type DeleteCatalogItemInstance204Response struct {
-}
+type ArchiveCatalogItem200JSONResponse CatalogItem
 
-func (response DeleteCatalogItemInstance204Response) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response ArchiveCatalogItem200JSONResponse) VisitArchiveCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+type ArchiveCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteCatalogItemInstance401JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response ArchiveCatalogItem401JSONResponse) VisitArchiveCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+type ArchiveCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteCatalogItemInstance403JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response ArchiveCatalogItem403JSONResponse) VisitArchiveCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+type ArchiveCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteCatalogItemInstance404JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response ArchiveCatalogItem404JSONResponse) VisitArchiveCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItemInstance500JSONResponse struct {
+type ArchiveCatalogItem409JSONResponse Error
+
+func (response ArchiveCatalogItem409JSONResponse) VisitArchiveCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ArchiveCatalogItem500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteCatalogItemInstance500JSONResponse) VisitDeleteCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response ArchiveCatalogItem500JSONResponse) VisitArchiveCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItemInstanceRequestObject struct {
-	CatalogItemInstanceId CatalogItemInstanceIdPath `json:"catalogItemInstanceId"`
+type EstimateCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Body          *EstimateCatalogItemJSONRequestBody
 }
 
-type GetCatalogItemInstanceResponseObject interface {
-	VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error
+type EstimateCatalogItemResponseObject interface {
+	VisitEstimateCatalogItemResponse(w http.ResponseWriter) error
 }
 
-type GetCatalogItemInstance200JSONResponse CatalogItemInstance
+type EstimateCatalogItem200JSONResponse CostEstimate
 
-func (response GetCatalogItemInstance200JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response EstimateCatalogItem200JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItemInstance401JSONResponse struct{ UnauthorizedJSONResponse }
+type EstimateCatalogItem400JSONResponse Error
 
-func (response GetCatalogItemInstance401JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response EstimateCatalogItem400JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response EstimateCatalogItem401JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItemInstance403JSONResponse struct{ ForbiddenJSONResponse }
+type EstimateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetCatalogItemInstance403JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response EstimateCatalogItem403JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItemInstance404JSONResponse struct{ NotFoundJSONResponse }
+type EstimateCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetCatalogItemInstance404JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response EstimateCatalogItem404JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItemInstance500JSONResponse struct {
+type EstimateCatalogItem409JSONResponse Error
+
+func (response EstimateCatalogItem409JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EstimateCatalogItem500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetCatalogItemInstance500JSONResponse) VisitGetCatalogItemInstanceResponse(w http.ResponseWriter) error {
+func (response EstimateCatalogItem500JSONResponse) VisitEstimateCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItemsRequestObject struct {
-	Params ListCatalogItemsParams
+type PreviewCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+	Body          *PreviewCatalogItemJSONRequestBody
 }
 
-type ListCatalogItemsResponseObject interface {
-	VisitListCatalogItemsResponse(w http.ResponseWriter) error
+type PreviewCatalogItemResponseObject interface {
+	VisitPreviewCatalogItemResponse(w http.ResponseWriter) error
 }
 
-type ListCatalogItems200JSONResponse CatalogItemList
+type PreviewCatalogItem200JSONResponse CatalogItemPreview
 
-func (response ListCatalogItems200JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+func (response PreviewCatalogItem200JSONResponse) VisitPreviewCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItems401JSONResponse struct{ UnauthorizedJSONResponse }
+type PreviewCatalogItem400JSONResponse Error
 
-func (response ListCatalogItems401JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+func (response PreviewCatalogItem400JSONResponse) VisitPreviewCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PreviewCatalogItem401JSONResponse) VisitPreviewCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItems403JSONResponse struct{ ForbiddenJSONResponse }
+type PreviewCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PreviewCatalogItem403JSONResponse) VisitPreviewCatalogItemResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ListCatalogItems403JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+func (response PreviewCatalogItem404JSONResponse) VisitPreviewCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListCatalogItems500JSONResponse struct {
+type PreviewCatalogItem500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response ListCatalogItems500JSONResponse) VisitListCatalogItemsResponse(w http.ResponseWriter) error {
+func (response PreviewCatalogItem500JSONResponse) VisitPreviewCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItemRequestObject struct {
-	Params CreateCatalogItemParams
-	Body   *CreateCatalogItemJSONRequestBody
+type PublishCatalogItemRequestObject struct {
+	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
 }
 
-type CreateCatalogItemResponseObject interface {
-	VisitCreateCatalogItemResponse(w http.ResponseWriter) error
+type PublishCatalogItemResponseObject interface {
+	VisitPublishCatalogItemResponse(w http.ResponseWriter) error
 }
 
-type CreateCatalogItem201JSONResponse CatalogItem
+type PublishCatalogItem200JSONResponse CatalogItem
 
-func (response CreateCatalogItem201JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+func (response PublishCatalogItem200JSONResponse) VisitPublishCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItem400JSONResponse Error
+type PublishCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateCatalogItem400JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+func (response PublishCatalogItem401JSONResponse) VisitPublishCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+type PublishCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response CreateCatalogItem401JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+func (response PublishCatalogItem403JSONResponse) VisitPublishCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+type PublishCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateCatalogItem403JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+func (response PublishCatalogItem404JSONResponse) VisitPublishCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItem409JSONResponse struct{ AlreadyExistsJSONResponse }
+type PublishCatalogItem409JSONResponse Error
 
-func (response CreateCatalogItem409JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+func (response PublishCatalogItem409JSONResponse) VisitPublishCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateCatalogItem500JSONResponse struct {
+type PublishCatalogItem500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response CreateCatalogItem500JSONResponse) VisitCreateCatalogItemResponse(w http.ResponseWriter) error {
+func (response PublishCatalogItem500JSONResponse) VisitPublishCatalogItemResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItemRequestObject struct {
-	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+type ExportBackstageCatalogItemsRequestObject struct {
 }
 
-type DeleteCatalogItemResponseObject interface {
-	VisitDeleteCatalogItemResponse(w http.ResponseWriter) error
+type ExportBackstageCatalogItemsResponseObject interface {
+	VisitExportBackstageCatalogItemsResponse(w http.ResponseWriter) error
 }
 
-type DeleteCatalogItem204Response struct {
+type ExportBackstageCatalogItems200ApplicationyamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
 }
 
-func (response DeleteCatalogItem204Response) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response ExportBackstageCatalogItems200ApplicationyamlResponse) VisitExportBackstageCatalogItemsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
 }
 
-type DeleteCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+type ExportBackstageCatalogItems401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteCatalogItem401JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+func (response ExportBackstageCatalogItems401JSONResponse) VisitExportBackstageCatalogItemsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+type ExportBackstageCatalogItems403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteCatalogItem403JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+func (response ExportBackstageCatalogItems403JSONResponse) VisitExportBackstageCatalogItemsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response DeleteCatalogItem404JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type DeleteCatalogItem500JSONResponse struct {
+type ExportBackstageCatalogItems500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response DeleteCatalogItem500JSONResponse) VisitDeleteCatalogItemResponse(w http.ResponseWriter) error {
+func (response ExportBackstageCatalogItems500JSONResponse) VisitExportBackstageCatalogItemsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItemRequestObject struct {
-	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
+type ListCatalogItemCategoriesRequestObject struct {
 }
 
-type GetCatalogItemResponseObject interface {
-	VisitGetCatalogItemResponse(w http.ResponseWriter) error
+type ListCatalogItemCategoriesResponseObject interface {
+	VisitListCatalogItemCategoriesResponse(w http.ResponseWriter) error
 }
 
-type GetCatalogItem200JSONResponse CatalogItem
+type ListCatalogItemCategories200JSONResponse CategoryList
 
-func (response GetCatalogItem200JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+func (response ListCatalogItemCategories200JSONResponse) VisitListCatalogItemCategoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListCatalogItemCategories401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetCatalogItem401JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+func (response ListCatalogItemCategories401JSONResponse) VisitListCatalogItemCategoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+type ListCatalogItemCategories403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetCatalogItem403JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+func (response ListCatalogItemCategories403JSONResponse) VisitListCatalogItemCategoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetCatalogItem404JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type GetCatalogItem500JSONResponse struct {
+type ListCatalogItemCategories500JSONResponse struct {
 	InternalServerErrorJSONResponse
 }
 
-func (response GetCatalogItem500JSONResponse) VisitGetCatalogItemResponse(w http.ResponseWriter) error {
+func (response ListCatalogItemCategories500JSONResponse) VisitListCatalogItemCategoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateCatalogItemRequestObject struct {
-	CatalogItemId CatalogItemIdPath `json:"catalogItemId"`
-	Body          *UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody
+type GetHealthRequestObject struct {
+	Params GetHealthParams
 }
 
-type UpdateCatalogItemResponseObject interface {
-	VisitUpdateCatalogItemResponse(w http.ResponseWriter) error
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
 }
 
-type UpdateCatalogItem200JSONResponse CatalogItem
+type GetHealth200JSONResponse Health
 
-func (response UpdateCatalogItem200JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateCatalogItem400JSONResponse Error
-
-func (response UpdateCatalogItem400JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+type SearchRequestObject struct {
+	Params SearchParams
+}
 
-	return json.NewEncoder(w).Encode(response)
+type SearchResponseObject interface {
+	VisitSearchResponse(w http.ResponseWriter) error
 }
 
-type UpdateCatalogItem401JSONResponse struct{ UnauthorizedJSONResponse }
+type Search200JSONResponse SearchResultList
 
-func (response UpdateCatalogItem401JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateCatalogItem403JSONResponse struct{ ForbiddenJSONResponse }
+type Search400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateCatalogItem403JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+func (response Search400JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateCatalogItem404JSONResponse struct{ NotFoundJSONResponse }
+type Search401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateCatalogItem404JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+func (response Search401JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateCatalogItem500JSONResponse struct {
-	InternalServerErrorJSONResponse
-}
+type Search403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpdateCatalogItem500JSONResponse) VisitUpdateCatalogItemResponse(w http.ResponseWriter) error {
+func (response Search403JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetHealthRequestObject struct {
-}
-
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
+type Search500JSONResponse struct {
+	InternalServerErrorJSONResponse
 }
 
-type GetHealth200JSONResponse Health
-
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+func (response Search500JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
@@ -1326,19 +2907,36 @@ func (response CreateServiceType500JSONResponse) VisitCreateServiceTypeResponse(
 
 type GetServiceTypeRequestObject struct {
 	ServiceTypeId ServiceTypeIdPath `json:"serviceTypeId"`
+	Params        GetServiceTypeParams
 }
 
 type GetServiceTypeResponseObject interface {
 	VisitGetServiceTypeResponse(w http.ResponseWriter) error
 }
 
-type GetServiceType200JSONResponse ServiceType
+type GetServiceType200ResponseHeaders struct {
+	ETag string
+}
+
+type GetServiceType200JSONResponse struct {
+	Body    ServiceType
+	Headers GetServiceType200ResponseHeaders
+}
 
 func (response GetServiceType200JSONResponse) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetServiceType304Response = NotModifiedResponse
+
+func (response GetServiceType304Response) VisitGetServiceTypeResponse(w http.ResponseWriter) error {
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(304)
+	return nil
 }
 
 type GetServiceType401JSONResponse struct{ UnauthorizedJSONResponse }
@@ -1379,6 +2977,22 @@ func (response GetServiceType500JSONResponse) VisitGetServiceTypeResponse(w http
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetVersionRequestObject struct {
+}
+
+type GetVersionResponseObject interface {
+	VisitGetVersionResponse(w http.ResponseWriter) error
+}
+
+type GetVersion200JSONResponse Version
+
+func (response GetVersion200JSONResponse) VisitGetVersionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// List catalog item instances
@@ -1393,6 +3007,18 @@ type StrictServerInterface interface {
 	// Get a catalog item instance
 	// (GET /catalog-item-instances/{catalogItemInstanceId})
 	GetCatalogItemInstance(ctx context.Context, request GetCatalogItemInstanceRequestObject) (GetCatalogItemInstanceResponseObject, error)
+	// Update a catalog item instance
+	// (PATCH /catalog-item-instances/{catalogItemInstanceId})
+	UpdateCatalogItemInstance(ctx context.Context, request UpdateCatalogItemInstanceRequestObject) (UpdateCatalogItemInstanceResponseObject, error)
+	// Update the status of a catalog item instance
+	// (PUT /catalog-item-instances/{catalogItemInstanceId}/status)
+	UpdateCatalogItemInstanceStatus(ctx context.Context, request UpdateCatalogItemInstanceStatusRequestObject) (UpdateCatalogItemInstanceStatusResponseObject, error)
+	// Clone a catalog item instance
+	// (POST /catalog-item-instances/{catalogItemInstanceId}:clone)
+	CloneCatalogItemInstance(ctx context.Context, request CloneCatalogItemInstanceRequestObject) (CloneCatalogItemInstanceResponseObject, error)
+	// Describe a catalog item instance
+	// (GET /catalog-item-instances/{catalogItemInstanceId}:describe)
+	DescribeCatalogItemInstance(ctx context.Context, request DescribeCatalogItemInstanceRequestObject) (DescribeCatalogItemInstanceResponseObject, error)
 	// List catalog items
 	// (GET /catalog-items)
 	ListCatalogItems(ctx context.Context, request ListCatalogItemsRequestObject) (ListCatalogItemsResponseObject, error)
@@ -1408,9 +3034,36 @@ type StrictServerInterface interface {
 	// Update a catalog item
 	// (PATCH /catalog-items/{catalogItemId})
 	UpdateCatalogItem(ctx context.Context, request UpdateCatalogItemRequestObject) (UpdateCatalogItemResponseObject, error)
+	// Get the icon of a catalog item
+	// (GET /catalog-items/{catalogItemId}/icon)
+	GetCatalogItemIcon(ctx context.Context, request GetCatalogItemIconRequestObject) (GetCatalogItemIconResponseObject, error)
+	// Get the validation bundle of a catalog item
+	// (GET /catalog-items/{catalogItemId}/validation-bundle)
+	GetCatalogItemValidationBundle(ctx context.Context, request GetCatalogItemValidationBundleRequestObject) (GetCatalogItemValidationBundleResponseObject, error)
+	// Archive a catalog item
+	// (POST /catalog-items/{catalogItemId}:archive)
+	ArchiveCatalogItem(ctx context.Context, request ArchiveCatalogItemRequestObject) (ArchiveCatalogItemResponseObject, error)
+	// Estimate the cost of a catalog item instance
+	// (POST /catalog-items/{catalogItemId}:estimate)
+	EstimateCatalogItem(ctx context.Context, request EstimateCatalogItemRequestObject) (EstimateCatalogItemResponseObject, error)
+	// Preview a catalog item instance
+	// (POST /catalog-items/{catalogItemId}:preview)
+	PreviewCatalogItem(ctx context.Context, request PreviewCatalogItemRequestObject) (PreviewCatalogItemResponseObject, error)
+	// Publish a catalog item
+	// (POST /catalog-items/{catalogItemId}:publish)
+	PublishCatalogItem(ctx context.Context, request PublishCatalogItemRequestObject) (PublishCatalogItemResponseObject, error)
+	// Export catalog items as Backstage entities
+	// (GET /catalog-items:exportBackstage)
+	ExportBackstageCatalogItems(ctx context.Context, request ExportBackstageCatalogItemsRequestObject) (ExportBackstageCatalogItemsResponseObject, error)
+	// List catalog item categories
+	// (GET /catalog-items:listCategories)
+	ListCatalogItemCategories(ctx context.Context, request ListCatalogItemCategoriesRequestObject) (ListCatalogItemCategoriesResponseObject, error)
 	// Health check
 	// (GET /health)
 	GetHealth(ctx context.Context, request GetHealthRequestObject) (GetHealthResponseObject, error)
+	// Search service types and catalog items
+	// (GET /search)
+	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
 	// List service types
 	// (GET /service-types)
 	ListServiceTypes(ctx context.Context, request ListServiceTypesRequestObject) (ListServiceTypesResponseObject, error)
@@ -1420,6 +3073,9 @@ type StrictServerInterface interface {
 	// Get a service type
 	// (GET /service-types/{serviceTypeId})
 	GetServiceType(ctx context.Context, request GetServiceTypeRequestObject) (GetServiceTypeResponseObject, error)
+	// Version information
+	// (GET /version)
+	GetVersion(ctx context.Context, request GetVersionRequestObject) (GetVersionResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -1445,31 +3101,150 @@ func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []Strict
 	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
-}
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListCatalogItemInstances operation middleware
+func (sh *strictHandler) ListCatalogItemInstances(w http.ResponseWriter, r *http.Request, params ListCatalogItemInstancesParams) {
+	var request ListCatalogItemInstancesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCatalogItemInstances(ctx, request.(ListCatalogItemInstancesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCatalogItemInstances")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCatalogItemInstancesResponseObject); ok {
+		if err := validResponse.VisitListCatalogItemInstancesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCatalogItemInstance operation middleware
+func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *http.Request, params CreateCatalogItemInstanceParams) {
+	var request CreateCatalogItemInstanceRequestObject
+
+	request.Params = params
+
+	var body CreateCatalogItemInstanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCatalogItemInstance(ctx, request.(CreateCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitCreateCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCatalogItemInstance operation middleware
+func (sh *strictHandler) DeleteCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	var request DeleteCatalogItemInstanceRequestObject
+
+	request.CatalogItemInstanceId = catalogItemInstanceId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCatalogItemInstance(ctx, request.(DeleteCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitDeleteCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCatalogItemInstance operation middleware
+func (sh *strictHandler) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params GetCatalogItemInstanceParams) {
+	var request GetCatalogItemInstanceRequestObject
+
+	request.CatalogItemInstanceId = catalogItemInstanceId
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogItemInstance(ctx, request.(GetCatalogItemInstanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogItemInstance")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitGetCatalogItemInstanceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCatalogItemInstance operation middleware
+func (sh *strictHandler) UpdateCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	var request UpdateCatalogItemInstanceRequestObject
 
-// ListCatalogItemInstances operation middleware
-func (sh *strictHandler) ListCatalogItemInstances(w http.ResponseWriter, r *http.Request, params ListCatalogItemInstancesParams) {
-	var request ListCatalogItemInstancesRequestObject
+	request.CatalogItemInstanceId = catalogItemInstanceId
 
-	request.Params = params
+	var body UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListCatalogItemInstances(ctx, request.(ListCatalogItemInstancesRequestObject))
+		return sh.ssi.UpdateCatalogItemInstance(ctx, request.(UpdateCatalogItemInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListCatalogItemInstances")
+		handler = middleware(handler, "UpdateCatalogItemInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListCatalogItemInstancesResponseObject); ok {
-		if err := validResponse.VisitListCatalogItemInstancesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitUpdateCatalogItemInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1477,13 +3252,13 @@ func (sh *strictHandler) ListCatalogItemInstances(w http.ResponseWriter, r *http
 	}
 }
 
-// CreateCatalogItemInstance operation middleware
-func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *http.Request, params CreateCatalogItemInstanceParams) {
-	var request CreateCatalogItemInstanceRequestObject
+// UpdateCatalogItemInstanceStatus operation middleware
+func (sh *strictHandler) UpdateCatalogItemInstanceStatus(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	var request UpdateCatalogItemInstanceStatusRequestObject
 
-	request.Params = params
+	request.CatalogItemInstanceId = catalogItemInstanceId
 
-	var body CreateCatalogItemInstanceJSONRequestBody
+	var body UpdateCatalogItemInstanceStatusJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1491,18 +3266,18 @@ func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *htt
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCatalogItemInstance(ctx, request.(CreateCatalogItemInstanceRequestObject))
+		return sh.ssi.UpdateCatalogItemInstanceStatus(ctx, request.(UpdateCatalogItemInstanceStatusRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCatalogItemInstance")
+		handler = middleware(handler, "UpdateCatalogItemInstanceStatus")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCatalogItemInstanceResponseObject); ok {
-		if err := validResponse.VisitCreateCatalogItemInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateCatalogItemInstanceStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateCatalogItemInstanceStatusResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1510,25 +3285,33 @@ func (sh *strictHandler) CreateCatalogItemInstance(w http.ResponseWriter, r *htt
 	}
 }
 
-// DeleteCatalogItemInstance operation middleware
-func (sh *strictHandler) DeleteCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
-	var request DeleteCatalogItemInstanceRequestObject
+// CloneCatalogItemInstance operation middleware
+func (sh *strictHandler) CloneCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath, params CloneCatalogItemInstanceParams) {
+	var request CloneCatalogItemInstanceRequestObject
 
 	request.CatalogItemInstanceId = catalogItemInstanceId
+	request.Params = params
+
+	var body CloneCatalogItemInstanceJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCatalogItemInstance(ctx, request.(DeleteCatalogItemInstanceRequestObject))
+		return sh.ssi.CloneCatalogItemInstance(ctx, request.(CloneCatalogItemInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCatalogItemInstance")
+		handler = middleware(handler, "CloneCatalogItemInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCatalogItemInstanceResponseObject); ok {
-		if err := validResponse.VisitDeleteCatalogItemInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(CloneCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitCloneCatalogItemInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1536,25 +3319,25 @@ func (sh *strictHandler) DeleteCatalogItemInstance(w http.ResponseWriter, r *htt
 	}
 }
 
-// GetCatalogItemInstance operation middleware
-func (sh *strictHandler) GetCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
-	var request GetCatalogItemInstanceRequestObject
+// DescribeCatalogItemInstance operation middleware
+func (sh *strictHandler) DescribeCatalogItemInstance(w http.ResponseWriter, r *http.Request, catalogItemInstanceId CatalogItemInstanceIdPath) {
+	var request DescribeCatalogItemInstanceRequestObject
 
 	request.CatalogItemInstanceId = catalogItemInstanceId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCatalogItemInstance(ctx, request.(GetCatalogItemInstanceRequestObject))
+		return sh.ssi.DescribeCatalogItemInstance(ctx, request.(DescribeCatalogItemInstanceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCatalogItemInstance")
+		handler = middleware(handler, "DescribeCatalogItemInstance")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCatalogItemInstanceResponseObject); ok {
-		if err := validResponse.VisitGetCatalogItemInstanceResponse(w); err != nil {
+	} else if validResponse, ok := response.(DescribeCatalogItemInstanceResponseObject); ok {
+		if err := validResponse.VisitDescribeCatalogItemInstanceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1622,10 +3405,11 @@ func (sh *strictHandler) CreateCatalogItem(w http.ResponseWriter, r *http.Reques
 }
 
 // DeleteCatalogItem operation middleware
-func (sh *strictHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+func (sh *strictHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params DeleteCatalogItemParams) {
 	var request DeleteCatalogItemRequestObject
 
 	request.CatalogItemId = catalogItemId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.DeleteCatalogItem(ctx, request.(DeleteCatalogItemRequestObject))
@@ -1648,10 +3432,11 @@ func (sh *strictHandler) DeleteCatalogItem(w http.ResponseWriter, r *http.Reques
 }
 
 // GetCatalogItem operation middleware
-func (sh *strictHandler) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+func (sh *strictHandler) GetCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath, params GetCatalogItemParams) {
 	var request GetCatalogItemRequestObject
 
 	request.CatalogItemId = catalogItemId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.GetCatalogItem(ctx, request.(GetCatalogItemRequestObject))
@@ -1706,10 +3491,230 @@ func (sh *strictHandler) UpdateCatalogItem(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// GetCatalogItemIcon operation middleware
+func (sh *strictHandler) GetCatalogItemIcon(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request GetCatalogItemIconRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogItemIcon(ctx, request.(GetCatalogItemIconRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogItemIcon")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCatalogItemIconResponseObject); ok {
+		if err := validResponse.VisitGetCatalogItemIconResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCatalogItemValidationBundle operation middleware
+func (sh *strictHandler) GetCatalogItemValidationBundle(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request GetCatalogItemValidationBundleRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCatalogItemValidationBundle(ctx, request.(GetCatalogItemValidationBundleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCatalogItemValidationBundle")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCatalogItemValidationBundleResponseObject); ok {
+		if err := validResponse.VisitGetCatalogItemValidationBundleResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ArchiveCatalogItem operation middleware
+func (sh *strictHandler) ArchiveCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request ArchiveCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ArchiveCatalogItem(ctx, request.(ArchiveCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ArchiveCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ArchiveCatalogItemResponseObject); ok {
+		if err := validResponse.VisitArchiveCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EstimateCatalogItem operation middleware
+func (sh *strictHandler) EstimateCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request EstimateCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	var body EstimateCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EstimateCatalogItem(ctx, request.(EstimateCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EstimateCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EstimateCatalogItemResponseObject); ok {
+		if err := validResponse.VisitEstimateCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewCatalogItem operation middleware
+func (sh *strictHandler) PreviewCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request PreviewCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	var body PreviewCatalogItemJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewCatalogItem(ctx, request.(PreviewCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PreviewCatalogItemResponseObject); ok {
+		if err := validResponse.VisitPreviewCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PublishCatalogItem operation middleware
+func (sh *strictHandler) PublishCatalogItem(w http.ResponseWriter, r *http.Request, catalogItemId CatalogItemIdPath) {
+	var request PublishCatalogItemRequestObject
+
+	request.CatalogItemId = catalogItemId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PublishCatalogItem(ctx, request.(PublishCatalogItemRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PublishCatalogItem")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PublishCatalogItemResponseObject); ok {
+		if err := validResponse.VisitPublishCatalogItemResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportBackstageCatalogItems operation middleware
+func (sh *strictHandler) ExportBackstageCatalogItems(w http.ResponseWriter, r *http.Request) {
+	var request ExportBackstageCatalogItemsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportBackstageCatalogItems(ctx, request.(ExportBackstageCatalogItemsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportBackstageCatalogItems")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportBackstageCatalogItemsResponseObject); ok {
+		if err := validResponse.VisitExportBackstageCatalogItemsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListCatalogItemCategories operation middleware
+func (sh *strictHandler) ListCatalogItemCategories(w http.ResponseWriter, r *http.Request) {
+	var request ListCatalogItemCategoriesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListCatalogItemCategories(ctx, request.(ListCatalogItemCategoriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListCatalogItemCategories")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListCatalogItemCategoriesResponseObject); ok {
+		if err := validResponse.VisitListCatalogItemCategoriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request, params GetHealthParams) {
 	var request GetHealthRequestObject
 
+	request.Params = params
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
 	}
@@ -1730,6 +3735,32 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// Search operation middleware
+func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
+	var request SearchRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Search(ctx, request.(SearchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Search")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SearchResponseObject); ok {
+		if err := validResponse.VisitSearchResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListServiceTypes operation middleware
 func (sh *strictHandler) ListServiceTypes(w http.ResponseWriter, r *http.Request, params ListServiceTypesParams) {
 	var request ListServiceTypesRequestObject
@@ -1790,10 +3821,11 @@ func (sh *strictHandler) CreateServiceType(w http.ResponseWriter, r *http.Reques
 }
 
 // GetServiceType operation middleware
-func (sh *strictHandler) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath) {
+func (sh *strictHandler) GetServiceType(w http.ResponseWriter, r *http.Request, serviceTypeId ServiceTypeIdPath, params GetServiceTypeParams) {
 	var request GetServiceTypeRequestObject
 
 	request.ServiceTypeId = serviceTypeId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
 		return sh.ssi.GetServiceType(ctx, request.(GetServiceTypeRequestObject))
@@ -1814,3 +3846,27 @@ func (sh *strictHandler) GetServiceType(w http.ResponseWriter, r *http.Request,
 		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
 	}
 }
+
+// GetVersion operation middleware
+func (sh *strictHandler) GetVersion(w http.ResponseWriter, r *http.Request) {
+	var request GetVersionRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetVersion(ctx, request.(GetVersionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetVersion")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetVersionResponseObject); ok {
+		if err := validResponse.VisitGetVersionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
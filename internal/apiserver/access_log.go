@@ -0,0 +1,144 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	AccessLogFormatJSON   = "json"
+	AccessLogFormatCommon = "common"
+)
+
+// Optional fields of JSON access log entries
+const (
+	AccessLogFieldLatency   = "latency"
+	AccessLogFieldStatus    = "status"
+	AccessLogFieldBytes     = "bytes"
+	AccessLogFieldClientIP  = "client_ip"
+	AccessLogFieldUserAgent = "user_agent"
+)
+
+var accessLogFields = []string{
+	AccessLogFieldLatency,
+	AccessLogFieldStatus,
+	AccessLogFieldBytes,
+	AccessLogFieldClientIP,
+	AccessLogFieldUserAgent,
+}
+
+type AccessLogOptions struct {
+	// Format is either "json" or "common" (NCSA Common Log Format)
+	Format string
+	// Fields selects the optional fields of JSON entries; the common
+	// format always has the same fields
+	Fields []string
+	// ExcludePaths are request paths that are not logged, e.g. health checks
+	ExcludePaths []string
+	Output       io.Writer
+}
+
+// AccessLog returns a middleware writing one line per request to opts.Output.
+func AccessLog(opts AccessLogOptions) (func(http.Handler) http.Handler, error) {
+	if err := validateAccessLog(opts.Format, opts.Fields); err != nil {
+		return nil, err
+	}
+
+	logger := &accessLogger{opts: opts}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if slices.Contains(opts.ExcludePaths, r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			start := time.Now()
+			defer func() {
+				logger.log(r, ww, start)
+			}()
+
+			next.ServeHTTP(ww, r)
+		})
+	}, nil
+}
+
+func validateAccessLog(format string, fields []string) error {
+	if format != AccessLogFormatJSON && format != AccessLogFormatCommon {
+		return fmt.Errorf("invalid access log format %q: must be %s or %s",
+			format, AccessLogFormatJSON, AccessLogFormatCommon)
+	}
+	for _, field := range fields {
+		if !slices.Contains(accessLogFields, field) {
+			return fmt.Errorf("invalid access log field %q: must be one of %v", field, accessLogFields)
+		}
+	}
+	return nil
+}
+
+type accessLogger struct {
+	opts AccessLogOptions
+	mu   sync.Mutex
+}
+
+func (l *accessLogger) log(r *http.Request, ww middleware.WrapResponseWriter, start time.Time) {
+	status := ww.Status()
+	if status == 0 {
+		status = http.StatusOK
+	}
+
+	var line []byte
+	switch l.opts.Format {
+	case AccessLogFormatCommon:
+		size := "-"
+		if ww.BytesWritten() > 0 {
+			size = fmt.Sprint(ww.BytesWritten())
+		}
+		line = fmt.Appendf(nil, "%s - - [%s] \"%s %s %s\" %d %s\n",
+			clientIP(r), start.Format("02/Jan/2006:15:04:05 -0700"),
+			r.Method, r.RequestURI, r.Proto, status, size)
+	default:
+		entry := map[string]any{
+			"time":       start.UTC().Format(time.RFC3339Nano),
+			"method":     r.Method,
+			"path":       r.URL.Path,
+			"request_id": middleware.GetReqID(r.Context()),
+		}
+		for _, field := range l.opts.Fields {
+			switch field {
+			case AccessLogFieldLatency:
+				entry["latency_ms"] = float64(time.Since(start)) / float64(time.Millisecond)
+			case AccessLogFieldStatus:
+				entry["status"] = status
+			case AccessLogFieldBytes:
+				entry["bytes"] = ww.BytesWritten()
+			case AccessLogFieldClientIP:
+				entry["client_ip"] = clientIP(r)
+			case AccessLogFieldUserAgent:
+				entry["user_agent"] = r.UserAgent()
+			}
+		}
+		line, _ = json.Marshal(entry)
+		line = append(line, '\n')
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.opts.Output.Write(line)
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,76 @@
+package apiserver_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+)
+
+var _ = Describe("AccessLog", func() {
+	var out *bytes.Buffer
+
+	hello := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte("hello"))
+	})
+
+	serve := func(opts apiserver.AccessLogOptions, path string) {
+		opts.Output = out
+		accessLog, err := apiserver.AccessLog(opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = "192.0.2.10:51234"
+		accessLog(hello).ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+	})
+
+	It("should write JSON entries with the selected fields", func() {
+		serve(apiserver.AccessLogOptions{
+			Format: apiserver.AccessLogFormatJSON,
+			Fields: []string{apiserver.AccessLogFieldStatus, apiserver.AccessLogFieldBytes, apiserver.AccessLogFieldClientIP},
+		}, "/api/v1alpha1/catalog-items")
+
+		var entry map[string]any
+		Expect(json.Unmarshal(out.Bytes(), &entry)).To(Succeed())
+		Expect(entry).To(HaveKeyWithValue("method", "GET"))
+		Expect(entry).To(HaveKeyWithValue("path", "/api/v1alpha1/catalog-items"))
+		Expect(entry).To(HaveKeyWithValue("status", BeNumerically("==", http.StatusAccepted)))
+		Expect(entry).To(HaveKeyWithValue("bytes", BeNumerically("==", 5)))
+		Expect(entry).To(HaveKeyWithValue("client_ip", "192.0.2.10"))
+		Expect(entry).ToNot(HaveKey("latency_ms"))
+	})
+
+	It("should write common log format entries", func() {
+		serve(apiserver.AccessLogOptions{Format: apiserver.AccessLogFormatCommon}, "/api/v1alpha1/catalog-items?max_page_size=5")
+
+		Expect(out.String()).To(MatchRegexp(
+			`^192\.0\.2\.10 - - \[[^\]]+\] "GET /api/v1alpha1/catalog-items\?max_page_size=5 HTTP/1\.1" 202 5\n$`))
+	})
+
+	It("should skip excluded paths", func() {
+		serve(apiserver.AccessLogOptions{
+			Format:       apiserver.AccessLogFormatJSON,
+			ExcludePaths: []string{"/api/v1alpha1/health"},
+		}, "/api/v1alpha1/health")
+
+		Expect(out.Len()).To(BeZero())
+	})
+
+	It("should reject unknown formats and fields", func() {
+		_, err := apiserver.AccessLog(apiserver.AccessLogOptions{Format: "xml"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = apiserver.AccessLog(apiserver.AccessLogOptions{Format: apiserver.AccessLogFormatJSON, Fields: []string{"cookie"}})
+		Expect(err).To(HaveOccurred())
+	})
+})
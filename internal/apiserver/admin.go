@@ -0,0 +1,94 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"expvar"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+const (
+	// A check is flapping when it transitioned flapThreshold times within
+	// the last flapWindow
+	flapWindow    = 10 * time.Minute
+	flapThreshold = 4
+)
+
+// AdminServer exposes runtime debugging endpoints (pprof and expvar) and
+// the health check history. It is meant to run on a separate, non-public
+// listener.
+type AdminServer struct {
+	listener  net.Listener
+	readiness *health.Checker
+}
+
+func NewAdmin(listener net.Listener, readiness *health.Checker) *AdminServer {
+	return &AdminServer{listener: listener, readiness: readiness}
+}
+
+func (s *AdminServer) Run(ctx context.Context) error {
+	return serve(ctx, &http.Server{Handler: AdminHandler(s.readiness)}, s.listener, "admin server", gracefulShutdownTimeout)
+}
+
+// AdminHandler serves net/http/pprof under /debug/pprof/, expvar under
+// /debug/vars and the transitions of the readiness checks under
+// /admin/health/history.
+func AdminHandler(readiness *health.Checker) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("GET /admin/health/history", healthHistoryHandler(readiness.History()))
+	return mux
+}
+
+type healthTransition struct {
+	Check  string    `json:"check"`
+	Time   time.Time `json:"time"`
+	Status string    `json:"status"`
+	Error  string    `json:"error,omitempty"`
+}
+
+type healthHistoryResponse struct {
+	Transitions []healthTransition `json:"transitions"`
+	Flapping    []string           `json:"flapping"`
+}
+
+// healthHistoryHandler lists the recent transitions of the checks, oldest
+// first, and the checks that are flapping, so that intermittent failures
+// are visible even when every check currently passes.
+func healthHistoryHandler(history *health.History) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		response := healthHistoryResponse{
+			Transitions: []healthTransition{},
+			Flapping:    history.Flapping(flapWindow, flapThreshold),
+		}
+		if response.Flapping == nil {
+			response.Flapping = []string{}
+		}
+		for _, transition := range history.Transitions() {
+			status := "healthy"
+			if !transition.Healthy {
+				status = "unhealthy"
+			}
+			response.Transitions = append(response.Transitions, healthTransition{
+				Check:  transition.Name,
+				Time:   transition.Time,
+				Status: status,
+				Error:  transition.Error,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Cache-Control", "no-store")
+		_ = json.NewEncoder(w).Encode(response)
+	}
+}
@@ -0,0 +1,81 @@
+package apiserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+var _ = Describe("AdminHandler", func() {
+	var (
+		handler   http.Handler
+		readiness *health.Checker
+	)
+
+	BeforeEach(func() {
+		readiness = health.NewChecker(time.Second)
+		handler = apiserver.AdminHandler(readiness)
+	})
+
+	It("should serve the pprof index", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(ContainSubstring("goroutine"))
+	})
+
+	It("should serve expvar variables", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var vars map[string]any
+		Expect(json.Unmarshal(rec.Body.Bytes(), &vars)).To(Succeed())
+		Expect(vars).To(HaveKey("memstats"))
+		Expect(vars).To(HaveKey("panics_total"))
+	})
+
+	It("should serve the health check history", func() {
+		var failing bool
+		readiness.Register("database", func(ctx context.Context) error {
+			if failing {
+				return errors.New("connection refused")
+			}
+			return nil
+		})
+		for _, f := range []bool{true, false, true, false} {
+			failing = f
+			readiness.Run(context.Background())
+		}
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/admin/health/history", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		var history struct {
+			Transitions []struct {
+				Check  string `json:"check"`
+				Status string `json:"status"`
+				Error  string `json:"error"`
+			} `json:"transitions"`
+			Flapping []string `json:"flapping"`
+		}
+		Expect(json.Unmarshal(rec.Body.Bytes(), &history)).To(Succeed())
+		Expect(history.Transitions).To(HaveLen(4))
+		Expect(history.Transitions[0].Check).To(Equal("database"))
+		Expect(history.Transitions[0].Status).To(Equal("unhealthy"))
+		Expect(history.Transitions[0].Error).To(Equal("connection refused"))
+		Expect(history.Transitions[3].Status).To(Equal("healthy"))
+		Expect(history.Flapping).To(ConsistOf("database"))
+	})
+})
@@ -0,0 +1,89 @@
+package apiserver
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/auth"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// operationRoles is the least privileged role allowed to call each
+// operation. Operations that are not listed require auth.RoleAdmin.
+var operationRoles = map[string]auth.Role{
+	"GetHealth":                       auth.RoleViewer,
+	"GetVersion":                      auth.RoleViewer,
+	"Search":                          auth.RoleViewer,
+	"ListServiceTypes":                auth.RoleViewer,
+	"GetServiceType":                  auth.RoleViewer,
+	"ListCatalogItems":                auth.RoleViewer,
+	"GetCatalogItem":                  auth.RoleViewer,
+	"PreviewCatalogItem":              auth.RoleViewer,
+	"EstimateCatalogItem":             auth.RoleViewer,
+	"GetCatalogItemValidationBundle":  auth.RoleViewer,
+	"GetCatalogItemIcon":              auth.RoleViewer,
+	"ExportBackstageCatalogItems":     auth.RoleViewer,
+	"ListCatalogItemCategories":       auth.RoleViewer,
+	"ListCatalogItemInstances":        auth.RoleViewer,
+	"GetCatalogItemInstance":          auth.RoleViewer,
+	"DescribeCatalogItemInstance":     auth.RoleViewer,
+	"CreateCatalogItem":               auth.RoleEditor,
+	"UpdateCatalogItem":               auth.RoleEditor,
+	"DeleteCatalogItem":               auth.RoleEditor,
+	"PublishCatalogItem":              auth.RoleEditor,
+	"ArchiveCatalogItem":              auth.RoleEditor,
+	"CreateCatalogItemInstance":       auth.RoleEditor,
+	"CloneCatalogItemInstance":        auth.RoleEditor,
+	"UpdateCatalogItemInstance":       auth.RoleEditor,
+	"DeleteCatalogItemInstance":       auth.RoleEditor,
+	"UpdateCatalogItemInstanceStatus": auth.RoleEditor,
+}
+
+// NewRoleBindings returns the role bindings of cfg when authorization is
+// enabled, or nil when it is not. Authorization relies on the identity of
+// client certificates, so tlsConfig, as returned by NewTLSConfig, must
+// verify them.
+func NewRoleBindings(cfg *config.Config, tlsConfig *tls.Config) (*auth.RoleBindings, error) {
+	if !cfg.AuthorizationEnabled {
+		return nil, nil
+	}
+	if tlsConfig == nil || tlsConfig.ClientCAs == nil {
+		return nil, errors.New("AUTHORIZATION_ENABLED requires TLS_CLIENT_CA_FILE to be set")
+	}
+	return auth.NewRoleBindings(cfg.RoleBindings, cfg.DefaultRole)
+}
+
+// authorize returns a strict middleware rejecting the operations that the
+// role bound to the client certificate does not grant, with 401 when the
+// client has no role and 403 when its role is not privileged enough.
+func authorize(bindings *auth.RoleBindings) server.StrictMiddlewareFunc {
+	return func(f server.StrictHandlerFunc, operationID string) server.StrictHandlerFunc {
+		required, ok := operationRoles[operationID]
+		if !ok {
+			required = auth.RoleAdmin
+		}
+
+		return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+			identity, _ := auth.ClientIdentityFromContext(ctx)
+			role, ok := bindings.RoleOf(identity)
+			if !ok {
+				writeProblem(w, http.StatusUnauthorized, v1alpha1.UNAUTHENTICATED, "Unauthenticated",
+					"A client certificate bound to a role is required", middleware.GetReqID(ctx))
+				return nil, nil
+			}
+			if !role.Grants(required) {
+				writeProblem(w, http.StatusForbidden, v1alpha1.PERMISSIONDENIED, "Permission denied",
+					fmt.Sprintf("Role '%s' does not allow %s, which requires role '%s'", role, operationID, required),
+					middleware.GetReqID(ctx))
+				return nil, nil
+			}
+			return f(ctx, w, r, request)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package apiserver_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+var _ = Describe("Authorization", func() {
+	var (
+		ca      *testCert
+		server  *testCert
+		baseURL string
+	)
+
+	clientCert := func(commonName string) *testCert {
+		return newTestCert(commonName, ca, false, time.Now().Add(-time.Minute), time.Now().Add(time.Hour))
+	}
+
+	BeforeEach(func() {
+		ca = validTestCert("client-ca")
+		server = validTestCert("localhost")
+		caFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+		Expect(os.WriteFile(caFile, ca.CertPEM, 0o600)).To(Succeed())
+
+		baseURL = httpsURL(startServer(&config.Config{
+			ReadinessTimeout:     time.Second,
+			TLSCert:              string(server.CertPEM),
+			TLSKey:               string(server.KeyPEM),
+			TLSClientCAFile:      caFile,
+			TLSClientAuth:        "require",
+			AuthorizationEnabled: true,
+			RoleBindings:         map[string]string{"reader": "viewer", "ci": "editor", "ops": "admin"},
+		}))
+	})
+
+	request := func(commonName, method, path string) (int, v1alpha1.Error) {
+		req, err := http.NewRequest(method, baseURL+"/api/v1alpha1"+path, strings.NewReader("{}"))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		resp, err := httpsClient(server, clientCert(commonName)).Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		var problem v1alpha1.Error
+		if resp.StatusCode >= 400 {
+			Expect(json.NewDecoder(resp.Body).Decode(&problem)).To(Succeed())
+		}
+		return resp.StatusCode, problem
+	}
+
+	It("should let viewers read", func() {
+		status, _ := request("reader", http.MethodGet, "/health")
+		Expect(status).To(Equal(http.StatusOK))
+	})
+
+	It("should not let viewers manage catalog items", func() {
+		status, problem := request("reader", http.MethodPost, "/catalog-items")
+		Expect(status).To(Equal(http.StatusForbidden))
+		Expect(problem.Type).To(Equal(v1alpha1.PERMISSIONDENIED))
+		Expect(*problem.Detail).To(ContainSubstring("CreateCatalogItem"))
+	})
+
+	It("should only let admins manage service types", func() {
+		status, problem := request("ci", http.MethodPost, "/service-types")
+		Expect(status).To(Equal(http.StatusForbidden))
+		Expect(problem.Type).To(Equal(v1alpha1.PERMISSIONDENIED))
+
+		// The stub answers once the request is authorized
+		_, problem = request("ops", http.MethodPost, "/service-types")
+		Expect(problem.Type).ToNot(Equal(v1alpha1.PERMISSIONDENIED))
+	})
+
+	It("should reject clients without a role", func() {
+		status, problem := request("stranger", http.MethodGet, "/health")
+		Expect(status).To(Equal(http.StatusUnauthorized))
+		Expect(problem.Type).To(Equal(v1alpha1.UNAUTHENTICATED))
+	})
+})
+
+var _ = Describe("NewRoleBindings", func() {
+	mtls := &tls.Config{ClientCAs: x509.NewCertPool()}
+
+	It("should return nil when authorization is disabled", func() {
+		bindings, err := apiserver.NewRoleBindings(&config.Config{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bindings).To(BeNil())
+	})
+
+	It("should require client certificates to be verified", func() {
+		cfg := &config.Config{AuthorizationEnabled: true}
+		_, err := apiserver.NewRoleBindings(cfg, nil)
+		Expect(err).To(MatchError(ContainSubstring("TLS_CLIENT_CA_FILE")))
+		_, err = apiserver.NewRoleBindings(cfg, &tls.Config{})
+		Expect(err).To(MatchError(ContainSubstring("TLS_CLIENT_CA_FILE")))
+	})
+
+	It("should reject invalid role bindings", func() {
+		_, err := apiserver.NewRoleBindings(&config.Config{
+			AuthorizationEnabled: true,
+			RoleBindings:         map[string]string{"ci": "owner"},
+		}, mtls)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return the role bindings", func() {
+		bindings, err := apiserver.NewRoleBindings(&config.Config{
+			AuthorizationEnabled: true,
+			RoleBindings:         map[string]string{"ci": "editor"},
+		}, mtls)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bindings).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,81 @@
+package apiserver_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/gomega"
+)
+
+// testCert is a PEM encoded certificate and private key.
+type testCert struct {
+	CertPEM []byte
+	KeyPEM  []byte
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+}
+
+// newTestCert creates a certificate for commonName, valid for 127.0.0.1,
+// between notBefore and notAfter. It is self-signed when parent is nil.
+func newTestCert(commonName string, parent *testCert, isCA bool, notBefore, notAfter time.Time) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		DNSNames:              []string{commonName},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+
+	signer, signerKey := template, key
+	if parent != nil {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	Expect(err).ToNot(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	Expect(err).ToNot(HaveOccurred())
+
+	return &testCert{
+		CertPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		KeyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cert:    cert,
+		key:     key,
+	}
+}
+
+// validTestCert creates a self-signed certificate valid for the next hour.
+func validTestCert(commonName string) *testCert {
+	return newTestCert(commonName, nil, true, time.Now().Add(-time.Minute), time.Now().Add(time.Hour))
+}
+
+// httpsClient returns a client trusting server and presenting clientCerts.
+func httpsClient(server *testCert, clientCerts ...*testCert) *http.Client {
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(server.CertPEM)
+	tlsConfig := &tls.Config{RootCAs: roots}
+	for _, cert := range clientCerts {
+		keyPair, err := tls.X509KeyPair(cert.CertPEM, cert.KeyPEM)
+		Expect(err).ToNot(HaveOccurred())
+		tlsConfig.Certificates = append(tlsConfig.Certificates, keyPair)
+	}
+	return &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+}
@@ -0,0 +1,27 @@
+package apiserver
+
+import (
+	"net/http"
+
+	"github.com/dcm-project/catalog-manager/internal/auth"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+)
+
+// ClientIdentity stores the identity of the client certificate in the
+// context (see auth.ClientIdentityFromContext) and attaches its common name
+// to the context logger. Only certificates verified against the client CA
+// bundle are trusted; requests without one pass through unchanged.
+func ClientIdentity(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		identity := auth.IdentityFromCertificate(r.TLS.VerifiedChains[0][0])
+		ctx := auth.WithClientIdentity(r.Context(), identity)
+		ctx = logging.WithLogger(ctx, logging.FromContext(ctx).With("client", identity.CommonName))
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,103 @@
+package apiserver_test
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/auth"
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+var _ = Describe("ClientIdentity", func() {
+	var (
+		identity      auth.ClientIdentity
+		authenticated bool
+	)
+
+	handler := apiserver.ClientIdentity(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		identity, authenticated = auth.ClientIdentityFromContext(r.Context())
+	}))
+
+	BeforeEach(func() {
+		identity, authenticated = auth.ClientIdentity{}, false
+	})
+
+	It("should expose the identity of a verified client certificate", func() {
+		client := validTestCert("provisioner")
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.TLS = &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{client.cert}}}
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		Expect(authenticated).To(BeTrue())
+		Expect(identity.CommonName).To(Equal("provisioner"))
+		Expect(identity.DNSNames).To(ConsistOf("provisioner"))
+	})
+
+	It("should not trust unverified certificates", func() {
+		client := validTestCert("provisioner")
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{client.cert}}
+
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		Expect(authenticated).To(BeFalse())
+	})
+
+	Describe("mutual TLS", func() {
+		var (
+			ca      *testCert
+			server  *testCert
+			baseURL string
+		)
+
+		BeforeEach(func() {
+			ca = validTestCert("client-ca")
+			server = validTestCert("localhost")
+			caFile := filepath.Join(GinkgoT().TempDir(), "ca.crt")
+			Expect(os.WriteFile(caFile, ca.CertPEM, 0o600)).To(Succeed())
+
+			baseURL = httpsURL(startServer(&config.Config{
+				ReadinessTimeout: time.Second,
+				TLSCert:          string(server.CertPEM),
+				TLSKey:           string(server.KeyPEM),
+				TLSClientCAFile:  caFile,
+				TLSClientAuth:    "require",
+			}))
+		})
+
+		It("should accept clients with a certificate signed by the client CA", func() {
+			client := newTestCert("provisioner", ca, false, time.Now().Add(-time.Minute), time.Now().Add(time.Hour))
+
+			resp, err := httpsClient(server, client).Get(baseURL + "/api/v1alpha1/health")
+			Expect(err).ToNot(HaveOccurred())
+			defer resp.Body.Close()
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should reject clients without a certificate", func() {
+			resp, err := httpsClient(server).Get(baseURL + "/api/v1alpha1/health")
+			if err == nil {
+				resp.Body.Close()
+			}
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject certificates signed by another CA", func() {
+			resp, err := httpsClient(server, validTestCert("intruder")).Get(baseURL + "/api/v1alpha1/health")
+			if err == nil {
+				resp.Body.Close()
+			}
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
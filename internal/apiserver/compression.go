@@ -0,0 +1,163 @@
+package apiserver
+
+import (
+	"bytes"
+	"compress/gzip"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var gzipWriters = sync.Pool{
+	New: func() any {
+		return gzip.NewWriter(nil)
+	},
+}
+
+// Compress returns a middleware compressing responses with gzip for clients
+// that accept it. Only textual responses of at least minSize bytes are
+// compressed, since smaller ones do not gain enough to be worth the CPU.
+func Compress(minSize int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			// The encoding depends on the request, even when not compressing
+			w.Header().Add("Vary", "Accept-Encoding")
+			if r.Method == http.MethodHead || !acceptsGzip(r.Header.Get("Accept-Encoding")) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			cw := &compressWriter{ResponseWriter: w, minSize: minSize, status: http.StatusOK}
+			next.ServeHTTP(cw, r)
+			cw.close()
+		})
+	}
+}
+
+// acceptsGzip reports whether the Accept-Encoding header value lists gzip,
+// or any encoding, with a non-zero quality.
+func acceptsGzip(header string) bool {
+	for _, entry := range strings.Split(header, ",") {
+		coding, params, _ := strings.Cut(entry, ";")
+		coding = strings.TrimSpace(coding)
+		if coding != "gzip" && coding != "*" {
+			continue
+		}
+		name, value, _ := strings.Cut(strings.TrimSpace(params), "=")
+		if strings.TrimSpace(name) != "q" {
+			return true
+		}
+		if q, err := strconv.ParseFloat(strings.TrimSpace(value), 64); err != nil || q > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// compressible reports whether responses of contentType are worth
+// compressing.
+func compressible(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+	for _, suffix := range []string{"json", "yaml", "xml", "javascript"} {
+		if strings.HasSuffix(mediaType, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// compressWriter holds back the response until minSize bytes are written,
+// then decides whether to compress it. Responses that end before reaching
+// minSize are sent as is.
+type compressWriter struct {
+	http.ResponseWriter
+	minSize int
+	status  int
+	buffer  bytes.Buffer
+
+	// decided is set once the status line is sent, gz when compressing
+	decided     bool
+	wroteHeader bool
+	gz          *gzip.Writer
+}
+
+func (w *compressWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.status = code
+	}
+}
+
+func (w *compressWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	if !w.decided {
+		w.buffer.Write(b)
+		if w.buffer.Len() < w.minSize {
+			return len(b), nil
+		}
+		if err := w.start(true); err != nil {
+			return 0, err
+		}
+		return len(b), nil
+	}
+	if w.gz != nil {
+		return w.gz.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// start sends the status line and the buffered body, compressing them if
+// allowed and the response lends itself to it.
+func (w *compressWriter) start(allowed bool) error {
+	w.decided = true
+	header := w.Header()
+	// Partial content is a range of the uncompressed representation
+	if allowed && header.Get("Content-Encoding") == "" && header.Get("Content-Range") == "" &&
+		compressible(header.Get("Content-Type")) {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		w.gz = gzipWriters.Get().(*gzip.Writer)
+		w.gz.Reset(w.ResponseWriter)
+	}
+	w.ResponseWriter.WriteHeader(w.status)
+
+	body := w.buffer.Bytes()
+	w.buffer = bytes.Buffer{}
+	if len(body) == 0 {
+		return nil
+	}
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(body)
+	} else {
+		_, err = w.ResponseWriter.Write(body)
+	}
+	return err
+}
+
+// close completes the response once the handler returns.
+func (w *compressWriter) close() {
+	if !w.decided {
+		if !w.wroteHeader {
+			return
+		}
+		_ = w.start(false)
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		gzipWriters.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
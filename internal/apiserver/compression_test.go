@@ -0,0 +1,100 @@
+package apiserver_test
+
+import (
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+)
+
+var _ = Describe("Compress", func() {
+	const minSize = 64
+
+	large := `{"results": [` + strings.Repeat(`{"display_name": "Small VM"},`, 20) + `{}]}`
+
+	serve := func(acceptEncoding, contentType, body string) *httptest.ResponseRecorder {
+		handler := apiserver.Compress(minSize)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", contentType)
+			// Write in small chunks, as encoders do
+			for i := 0; i < len(body); i += 16 {
+				_, _ = w.Write([]byte(body[i:min(i+16, len(body))]))
+			}
+		}))
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/catalog-items", nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	gunzip := func(rec *httptest.ResponseRecorder) string {
+		reader, err := gzip.NewReader(rec.Body)
+		Expect(err).ToNot(HaveOccurred())
+		body, err := io.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		return string(body)
+	}
+
+	It("should compress large responses for clients accepting gzip", func() {
+		rec := serve("br, gzip", "application/json", large)
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Encoding")).To(Equal("gzip"))
+		Expect(rec.Header().Get("Vary")).To(Equal("Accept-Encoding"))
+		Expect(rec.Body.Len()).To(BeNumerically("<", len(large)))
+		Expect(gunzip(rec)).To(Equal(large))
+	})
+
+	It("should send responses below the minimum size as is", func() {
+		rec := serve("gzip", "application/json", `{"status": "ok"}`)
+
+		Expect(rec.Header().Get("Content-Encoding")).To(BeEmpty())
+		Expect(rec.Body.String()).To(Equal(`{"status": "ok"}`))
+	})
+
+	It("should not compress for clients not accepting gzip", func() {
+		for _, acceptEncoding := range []string{"", "br", "gzip;q=0"} {
+			rec := serve(acceptEncoding, "application/json", large)
+
+			Expect(rec.Header().Get("Content-Encoding")).To(BeEmpty(), acceptEncoding)
+			Expect(rec.Body.String()).To(Equal(large))
+		}
+	})
+
+	It("should vary on Accept-Encoding for clients not accepting gzip", func() {
+		rec := serve("", "application/json", large)
+
+		Expect(rec.Header().Get("Content-Encoding")).To(BeEmpty())
+		Expect(rec.Header().Get("Vary")).To(Equal("Accept-Encoding"))
+	})
+
+	It("should not compress binary content", func() {
+		rec := serve("gzip", "image/png", large)
+
+		Expect(rec.Header().Get("Content-Encoding")).To(BeEmpty())
+		Expect(rec.Body.String()).To(Equal(large))
+	})
+
+	It("should keep the status of the response", func() {
+		handler := apiserver.Compress(minSize)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(large))
+		}))
+		req := httptest.NewRequest(http.MethodPost, "/api/v1alpha1/catalog-items", nil)
+		req.Header.Set("Accept-Encoding", "gzip")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+		Expect(gunzip(rec)).To(Equal(large))
+	})
+})
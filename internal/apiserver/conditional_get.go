@@ -0,0 +1,84 @@
+package apiserver
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	etagHeader        = "ETag"
+	ifNoneMatchHeader = "If-None-Match"
+)
+
+// ConditionalGet returns a middleware tagging the successful responses of
+// the operations that accept the If-None-Match header with a weak ETag
+// derived from their body, and answering 304 Not Modified without a body
+// when the client already holds a matching representation, so that polling
+// clients and caches do not transfer unchanged resources again.
+//
+// The operation is identified by the route pattern, so the middleware must
+// be used on the routes of swagger mounted at baseURL.
+func ConditionalGet(swagger *openapi3.T, baseURL string) func(http.Handler) http.Handler {
+	routes := map[string]bool{}
+	for path, item := range swagger.Paths.Map() {
+		if item.Get == nil || item.Get.Parameters.GetByInAndName(openapi3.ParameterInHeader, ifNoneMatchHeader) == nil {
+			continue
+		}
+		routes[baseURL+path] = true
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || !routes[chi.RouteContext(r.Context()).RoutePattern()] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(bw, r)
+
+			body := bw.body.Bytes()
+			if bw.status != http.StatusOK {
+				w.WriteHeader(bw.status)
+				_, _ = w.Write(body)
+				return
+			}
+
+			etag := w.Header().Get(etagHeader)
+			if etag == "" {
+				sum := sha256.Sum256(body)
+				etag = `W/"` + hex.EncodeToString(sum[:16]) + `"`
+				w.Header().Set(etagHeader, etag)
+			}
+			if etagMatches(r.Header.Values(ifNoneMatchHeader), etag) {
+				w.Header().Del("Content-Type")
+				w.Header().Del("Content-Length")
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.WriteHeader(bw.status)
+			_, _ = w.Write(body)
+		})
+	}
+}
+
+// etagMatches reports whether etag matches any of the comma-separated
+// entity tags in values, using the weak comparison that If-None-Match
+// requires.
+func etagMatches(values []string, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, value := range values {
+		for _, candidate := range strings.Split(value, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
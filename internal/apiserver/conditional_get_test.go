@@ -0,0 +1,100 @@
+package apiserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/go-chi/chi/v5"
+)
+
+var _ = Describe("ConditionalGet", func() {
+	var (
+		router      chi.Router
+		displayName string
+	)
+
+	BeforeEach(func() {
+		swagger, err := v1alpha1.GetSwagger()
+		Expect(err).ToNot(HaveOccurred())
+
+		displayName = "Small VM"
+		router = chi.NewRouter()
+		router.Group(func(r chi.Router) {
+			r.Use(apiserver.ConditionalGet(swagger, "/api/v1alpha1"))
+			r.Get("/api/v1alpha1/catalog-items/{catalogItemId}", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if chi.URLParam(r, "catalogItemId") != "small-vm" {
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"type": "NOT_FOUND", "status": 404, "title": "Resource not found"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`{"display_name": "` + displayName + `"}`))
+			})
+			r.Get("/api/v1alpha1/catalog-items", func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(`{"results": [], "next_page_token": ""}`))
+			})
+		})
+	})
+
+	get := func(target string, ifNoneMatch ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		for _, value := range ifNoneMatch {
+			req.Header.Add("If-None-Match", value)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("should tag resources with a weak ETag", func() {
+		rec := get("/api/v1alpha1/catalog-items/small-vm")
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("ETag")).To(MatchRegexp(`^W/"[0-9a-f]{32}"$`))
+		Expect(rec.Body.String()).To(MatchJSON(`{"display_name": "Small VM"}`))
+	})
+
+	It("should answer 304 without a body when the ETag matches", func() {
+		etag := get("/api/v1alpha1/catalog-items/small-vm").Header().Get("ETag")
+
+		for _, ifNoneMatch := range []string{etag, `W/"other", ` + etag, etag[2:], "*"} {
+			rec := get("/api/v1alpha1/catalog-items/small-vm", ifNoneMatch)
+
+			Expect(rec.Code).To(Equal(http.StatusNotModified), ifNoneMatch)
+			Expect(rec.Header().Get("ETag")).To(Equal(etag))
+			Expect(rec.Header().Get("Content-Type")).To(BeEmpty())
+			Expect(rec.Body.Len()).To(BeZero())
+		}
+	})
+
+	It("should return the resource once it changed", func() {
+		etag := get("/api/v1alpha1/catalog-items/small-vm").Header().Get("ETag")
+		displayName = "Tiny VM"
+
+		rec := get("/api/v1alpha1/catalog-items/small-vm", etag)
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("ETag")).ToNot(Equal(etag))
+		Expect(rec.Body.String()).To(MatchJSON(`{"display_name": "Tiny VM"}`))
+	})
+
+	It("should leave errors alone", func() {
+		rec := get("/api/v1alpha1/catalog-items/large-vm", "*")
+
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+		Expect(rec.Header().Get("ETag")).To(BeEmpty())
+	})
+
+	It("should leave operations without If-None-Match alone", func() {
+		rec := get("/api/v1alpha1/catalog-items", "*")
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("ETag")).To(BeEmpty())
+	})
+})
@@ -0,0 +1,98 @@
+package apiserver
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+type CORSOptions struct {
+	// AllowedOrigins are the origins allowed to call the API from a
+	// browser; "*" allows any origin
+	AllowedOrigins []string
+	AllowedMethods []string
+	AllowedHeaders []string
+	// MaxAge is how long browsers may cache the result of a preflight
+	MaxAge time.Duration
+}
+
+// CORS returns a middleware implementing Cross-Origin Resource Sharing for
+// the allowed origins. Preflight requests are answered directly, without
+// reaching the handlers; requests from other origins are served without
+// CORS headers, so that browsers block them.
+func CORS(opts CORSOptions) func(http.Handler) http.Handler {
+	return newCORS(opts).middleware
+}
+
+// cors holds the CORS policy; the allowed origins can be replaced while
+// serving.
+type cors struct {
+	opts           CORSOptions
+	allowedMethods string
+	allowedHeaders string
+	origins        atomic.Pointer[[]string]
+}
+
+func newCORS(opts CORSOptions) *cors {
+	c := &cors{
+		opts:           opts,
+		allowedMethods: strings.Join(opts.AllowedMethods, ", "),
+		allowedHeaders: strings.Join(opts.AllowedHeaders, ", "),
+	}
+	c.setAllowedOrigins(opts.AllowedOrigins)
+	return c
+}
+
+func (c *cors) setAllowedOrigins(origins []string) {
+	origins = slices.Clone(origins)
+	c.origins.Store(&origins)
+}
+
+func (c *cors) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origins := *c.origins.Load()
+		anyOrigin := slices.Contains(origins, "*")
+
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		w.Header().Add("Vary", "Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+		}
+
+		allowed := anyOrigin || slices.Contains(origins, origin)
+		if allowed {
+			if anyOrigin {
+				w.Header().Set("Access-Control-Allow-Origin", "*")
+			} else {
+				w.Header().Set("Access-Control-Allow-Origin", origin)
+			}
+		}
+
+		if !preflight {
+			if allowed {
+				w.Header().Set("Access-Control-Expose-Headers", RequestIDHeader+", "+etagHeader)
+			}
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		if allowed && slices.Contains(c.opts.AllowedMethods, r.Header.Get("Access-Control-Request-Method")) {
+			w.Header().Set("Access-Control-Allow-Methods", c.allowedMethods)
+			w.Header().Set("Access-Control-Allow-Headers", c.allowedHeaders)
+			if c.opts.MaxAge > 0 {
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(c.opts.MaxAge.Seconds())))
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,97 @@
+package apiserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+)
+
+var _ = Describe("CORS", func() {
+	var (
+		handler http.Handler
+		served  bool
+	)
+
+	opts := apiserver.CORSOptions{
+		AllowedOrigins: []string{"https://ui.example.com"},
+		AllowedMethods: []string{"GET", "POST"},
+		AllowedHeaders: []string{"Content-Type", "X-Request-ID"},
+		MaxAge:         10 * time.Minute,
+	}
+
+	BeforeEach(func() {
+		served = false
+		handler = apiserver.CORS(opts)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			served = true
+		}))
+	})
+
+	request := func(method, origin string, headers ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/api/v1alpha1/catalog-items", nil)
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("should not touch same-origin requests", func() {
+		rec := request(http.MethodGet, "")
+
+		Expect(served).To(BeTrue())
+		Expect(rec.Header()).ToNot(HaveKey("Access-Control-Allow-Origin"))
+	})
+
+	It("should allow requests from allowed origins", func() {
+		rec := request(http.MethodGet, "https://ui.example.com")
+
+		Expect(served).To(BeTrue())
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://ui.example.com"))
+		Expect(rec.Header().Get("Access-Control-Expose-Headers")).To(Equal(apiserver.RequestIDHeader + ", ETag"))
+		Expect(rec.Header().Values("Vary")).To(ContainElement("Origin"))
+	})
+
+	It("should not allow requests from other origins", func() {
+		rec := request(http.MethodGet, "https://evil.example.com")
+
+		Expect(served).To(BeTrue())
+		Expect(rec.Header()).ToNot(HaveKey("Access-Control-Allow-Origin"))
+	})
+
+	It("should answer preflight requests", func() {
+		rec := request(http.MethodOptions, "https://ui.example.com",
+			"Access-Control-Request-Method", "POST",
+			"Access-Control-Request-Headers", "content-type")
+
+		Expect(served).To(BeFalse())
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://ui.example.com"))
+		Expect(rec.Header().Get("Access-Control-Allow-Methods")).To(Equal("GET, POST"))
+		Expect(rec.Header().Get("Access-Control-Allow-Headers")).To(Equal("Content-Type, X-Request-ID"))
+		Expect(rec.Header().Get("Access-Control-Max-Age")).To(Equal("600"))
+	})
+
+	It("should not allow methods that are not configured in preflight requests", func() {
+		rec := request(http.MethodOptions, "https://ui.example.com", "Access-Control-Request-Method", "DELETE")
+
+		Expect(served).To(BeFalse())
+		Expect(rec.Header()).ToNot(HaveKey("Access-Control-Allow-Methods"))
+	})
+
+	It("should allow any origin with a wildcard", func() {
+		handler = apiserver.CORS(apiserver.CORSOptions{AllowedOrigins: []string{"*"}})(http.NotFoundHandler())
+
+		rec := request(http.MethodGet, "https://anywhere.example.com")
+
+		Expect(rec.Header().Get("Access-Control-Allow-Origin")).To(Equal("*"))
+	})
+})
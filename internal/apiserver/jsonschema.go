@@ -0,0 +1,165 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	jsonSchemaDialect   = "https://json-schema.org/draft/2020-12/schema"
+	componentsSchemaRef = "#/components/schemas/"
+)
+
+// schemaKinds are the resource kinds whose JSON Schema is served
+var schemaKinds = []string{"ServiceType", "CatalogItem", "CatalogItemInstance"}
+
+// jsonSchemasHandler serves the JSON Schema (draft 2020-12) of each resource
+// kind, derived from the OpenAPI components, so that manifest authors can
+// validate their files in editors.
+func jsonSchemasHandler(swagger *openapi3.T) (http.HandlerFunc, error) {
+	documents := map[string][]byte{}
+	for _, kind := range schemaKinds {
+		schema, err := jsonSchema(swagger, kind)
+		if err != nil {
+			return nil, err
+		}
+		body, err := json.Marshal(schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal JSON Schema of %s: %w", kind, err)
+		}
+		documents[kind] = body
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		kind := chi.URLParam(r, "kind")
+		body, ok := documents[kind]
+		if !ok {
+			writeProblem(w, http.StatusNotFound, v1alpha1.NOTFOUND, "Resource not found",
+				fmt.Sprintf("No schema for kind '%s'; known kinds: %s", kind, strings.Join(schemaKinds, ", ")),
+				middleware.GetReqID(r.Context()))
+			return
+		}
+		w.Header().Set("Content-Type", "application/schema+json")
+		_, _ = w.Write(body)
+	}, nil
+}
+
+// jsonSchema converts the named OpenAPI component into a standalone JSON
+// Schema document, with the components it references inlined under $defs.
+func jsonSchema(swagger *openapi3.T, name string) (map[string]any, error) {
+	root, err := componentSchema(swagger, name)
+	if err != nil {
+		return nil, err
+	}
+
+	defs := map[string]any{}
+	pending := convertSchema(root)
+	for len(pending) > 0 {
+		ref := pending[0]
+		pending = pending[1:]
+		if _, ok := defs[ref]; ok || ref == name {
+			continue
+		}
+		def, err := componentSchema(swagger, ref)
+		if err != nil {
+			return nil, err
+		}
+		defs[ref] = def
+		pending = append(pending, convertSchema(def)...)
+	}
+
+	root["$schema"] = jsonSchemaDialect
+	root["title"] = name
+	if len(defs) > 0 {
+		root["$defs"] = defs
+	}
+	return root, nil
+}
+
+// componentSchema returns the named component schema as generic JSON.
+func componentSchema(swagger *openapi3.T, name string) (map[string]any, error) {
+	ref, ok := swagger.Components.Schemas[name]
+	if !ok || ref.Value == nil {
+		return nil, fmt.Errorf("schema %s not found in the OpenAPI spec", name)
+	}
+	body, err := json.Marshal(ref.Value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema %s: %w", name, err)
+	}
+	var schema map[string]any
+	if err := json.Unmarshal(body, &schema); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal schema %s: %w", name, err)
+	}
+	return schema, nil
+}
+
+// convertSchema rewrites an OpenAPI 3.0 schema into JSON Schema in place and
+// returns the names of the components it references.
+func convertSchema(node any) []string {
+	var refs []string
+	switch v := node.(type) {
+	case map[string]any:
+		for key := range v {
+			if strings.HasPrefix(key, "x-") {
+				delete(v, key)
+			}
+		}
+		if ref, ok := v["$ref"].(string); ok && strings.HasPrefix(ref, componentsSchemaRef) {
+			name := strings.TrimPrefix(ref, componentsSchemaRef)
+			v["$ref"] = "#/$defs/" + name
+			refs = append(refs, name)
+		}
+		if nullable, ok := v["nullable"].(bool); ok {
+			delete(v, "nullable")
+			if nullable {
+				makeNullable(v)
+			}
+		}
+		if example, ok := v["example"]; ok {
+			delete(v, "example")
+			v["examples"] = []any{example}
+		}
+		for key, child := range v {
+			// Do not mistake user-defined property names for keywords
+			if key == "properties" {
+				if properties, ok := child.(map[string]any); ok {
+					for _, property := range properties {
+						refs = append(refs, convertSchema(property)...)
+					}
+				}
+				continue
+			}
+			if key == "examples" || key == "default" || key == "enum" {
+				continue
+			}
+			refs = append(refs, convertSchema(child)...)
+		}
+	case []any:
+		for _, child := range v {
+			refs = append(refs, convertSchema(child)...)
+		}
+	}
+	return refs
+}
+
+func makeNullable(schema map[string]any) {
+	switch t := schema["type"].(type) {
+	case string:
+		schema["type"] = []any{t, "null"}
+	case []any:
+		if !slices.Contains(t, any("null")) {
+			schema["type"] = append(t, "null")
+		}
+	}
+	if enum, ok := schema["enum"].([]any); ok && !slices.Contains(enum, nil) {
+		schema["enum"] = append(enum, nil)
+	}
+}
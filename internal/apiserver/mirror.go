@@ -0,0 +1,215 @@
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"reflect"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/auth"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	// maxMirroredBodySize bounds the primary response kept for comparison;
+	// larger responses are not mirrored
+	maxMirroredBodySize = 1 << 20
+	// maxInFlightMirrors bounds the concurrent mirrored requests; requests
+	// beyond it are not mirrored, so that a slow mirror never backs up the
+	// primary
+	maxInFlightMirrors = 64
+)
+
+// credentialHeaders are not sent to the mirror, which may be run by a
+// third party
+var credentialHeaders = []string{
+	"Authorization", "Proxy-Authorization", "Cookie", "X-Forwarded-Client-Cert", "X-Client-Cert",
+}
+
+var (
+	mirroredTotal    = expvar.NewInt("mirror_requests_total")
+	divergencesTotal = expvar.NewInt("mirror_divergences_total")
+	mirrorErrorTotal = expvar.NewInt("mirror_errors_total")
+)
+
+type MirrorOptions struct {
+	// Target is the base URL of the secondary instance, e.g. a canary
+	Target string
+	// Percent is the share of read requests that are mirrored, from 0 to 100
+	Percent float64
+	// Timeout bounds each mirrored request
+	Timeout time.Duration
+	// Client sends the mirrored requests; http.DefaultClient when nil
+	Client *http.Client
+}
+
+// Mirror returns a middleware that replays a sample of read (GET and HEAD)
+// requests against opts.Target after they have been served, and compares
+// the status and body of both responses. Divergences are logged and counted
+// (mirror_divergences_total); the mirrored response never reaches the client.
+// Probes are not mirrored, and credentials are stripped from the mirrored
+// requests. Neither are requests with a client identity (see ClientIdentity):
+// the mirror cannot present the client certificate, so their responses,
+// e.g. when authorization is enabled, would always diverge.
+func Mirror(opts MirrorOptions) (func(http.Handler) http.Handler, error) {
+	target, err := parseMirrorOptions(opts.Target, opts.Percent)
+	if err != nil {
+		return nil, err
+	}
+	client := opts.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	m := &mirror{
+		target:   target,
+		timeout:  opts.Timeout,
+		client:   client,
+		inFlight: make(chan struct{}, maxInFlightMirrors),
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if (r.Method != http.MethodGet && r.Method != http.MethodHead) ||
+				r.URL.Path == livenessPath || r.URL.Path == readinessPath ||
+				hasClientIdentity(r) || rand.Float64()*100 >= opts.Percent {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body := &cappedBuffer{limit: maxMirroredBodySize}
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(body)
+			next.ServeHTTP(ww, r)
+
+			if body.overflow {
+				return
+			}
+			select {
+			case m.inFlight <- struct{}{}:
+			default:
+				return
+			}
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			go func() {
+				defer func() { <-m.inFlight }()
+				m.compare(r, status, body.Bytes())
+			}()
+		})
+	}, nil
+}
+
+func hasClientIdentity(r *http.Request) bool {
+	_, ok := auth.ClientIdentityFromContext(r.Context())
+	return ok
+}
+
+// parseMirrorOptions returns the URL of the mirror target, after checking
+// that it is absolute and that percent is a valid share of requests.
+func parseMirrorOptions(target string, percent float64) (*url.URL, error) {
+	u, err := url.Parse(target)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid mirror URL %q: must be an absolute URL", target)
+	}
+	if percent < 0 || percent > 100 {
+		return nil, fmt.Errorf("invalid mirror percent %v: must be between 0 and 100", percent)
+	}
+	return u, nil
+}
+
+type mirror struct {
+	target   *url.URL
+	timeout  time.Duration
+	client   *http.Client
+	inFlight chan struct{}
+}
+
+// compare sends r to the mirror and reports whether its response diverges
+// from the primary one.
+func (m *mirror) compare(r *http.Request, status int, body []byte) {
+	// The request context is cancelled once the primary response is sent
+	ctx := context.WithoutCancel(r.Context())
+	if m.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, m.timeout)
+		defer cancel()
+	}
+	logger := logging.FromContext(ctx).With("method", r.Method, "path", r.URL.Path)
+	mirroredTotal.Add(1)
+
+	mirrorURL := *m.target
+	mirrorURL.Path = m.target.Path + r.URL.Path
+	mirrorURL.RawQuery = r.URL.RawQuery
+	req, err := http.NewRequestWithContext(ctx, r.Method, mirrorURL.String(), nil)
+	if err != nil {
+		mirrorErrorTotal.Add(1)
+		logger.Warn("Failed to build mirrored request", "error", err)
+		return
+	}
+	req.Header = r.Header.Clone()
+	for _, name := range credentialHeaders {
+		req.Header.Del(name)
+	}
+	// The primary body is compared before compression; let the transport
+	// negotiate and decode the encoding of the mirrored response
+	req.Header.Del("Accept-Encoding")
+	// Keep the same request ID so that both sides can be correlated
+	req.Header.Set(RequestIDHeader, middleware.GetReqID(r.Context()))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		mirrorErrorTotal.Add(1)
+		logger.Warn("Mirrored request failed", "error", err)
+		return
+	}
+	defer resp.Body.Close()
+	mirrorBody, err := io.ReadAll(io.LimitReader(resp.Body, maxMirroredBodySize+1))
+	if err != nil {
+		mirrorErrorTotal.Add(1)
+		logger.Warn("Failed to read mirrored response", "error", err)
+		return
+	}
+
+	if resp.StatusCode != status || !sameBody(body, mirrorBody) {
+		divergencesTotal.Add(1)
+		logger.Warn("Mirrored response diverges",
+			"status", status, "mirror_status", resp.StatusCode,
+			"bytes", len(body), "mirror_bytes", len(mirrorBody))
+	}
+}
+
+// sameBody compares JSON bodies semantically, ignoring key order and
+// formatting, and any other body byte by byte.
+func sameBody(a, b []byte) bool {
+	var va, vb any
+	if json.Unmarshal(a, &va) == nil && json.Unmarshal(b, &vb) == nil {
+		return reflect.DeepEqual(va, vb)
+	}
+	return bytes.Equal(a, b)
+}
+
+// cappedBuffer keeps up to limit bytes and records whether more were written.
+type cappedBuffer struct {
+	bytes.Buffer
+	limit    int
+	overflow bool
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if b.overflow || b.Len()+len(p) > b.limit {
+		b.overflow = true
+		b.Reset()
+		return len(p), nil
+	}
+	return b.Buffer.Write(p)
+}
@@ -0,0 +1,162 @@
+package apiserver_test
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/auth"
+)
+
+var _ = Describe("Mirror", func() {
+	var (
+		mirrorBody  atomic.Value
+		mirrorCalls atomic.Int32
+		mirrorPath  atomic.Value
+		mirrorReq   atomic.Value
+		mirrorSrv   *httptest.Server
+	)
+
+	primary := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"status": "healthy", "path": "/health"}`))
+	})
+
+	counter := func(name string) func() int64 {
+		return func() int64 { return expvar.Get(name).(*expvar.Int).Value() }
+	}
+
+	newMirror := func(percent float64) http.Handler {
+		mw, err := apiserver.Mirror(apiserver.MirrorOptions{
+			Target:  mirrorSrv.URL + "/canary",
+			Percent: percent,
+			Timeout: time.Second,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return apiserver.RequestID(mw(primary))
+	}
+
+	BeforeEach(func() {
+		mirrorCalls.Store(0)
+		mirrorBody.Store(`{"path":"/health","status":"healthy"}`)
+		mirrorSrv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mirrorPath.Store(r.URL.RequestURI())
+			mirrorReq.Store(r.Header.Clone())
+			mirrorCalls.Add(1)
+			_, _ = w.Write([]byte(mirrorBody.Load().(string)))
+		}))
+		DeferCleanup(mirrorSrv.Close)
+	})
+
+	It("should replay read requests against the mirror", func() {
+		mirrored := counter("mirror_requests_total")()
+		divergences := counter("mirror_divergences_total")()
+
+		rec := httptest.NewRecorder()
+		newMirror(100).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/v1alpha1/health?view=FULL", nil))
+
+		Expect(rec.Body.String()).To(ContainSubstring("healthy"))
+		Eventually(counter("mirror_requests_total")).Should(Equal(mirrored + 1))
+		Expect(mirrorPath.Load()).To(Equal("/canary/api/v1alpha1/health?view=FULL"))
+		Consistently(counter("mirror_divergences_total"), 100*time.Millisecond).Should(Equal(divergences))
+	})
+
+	It("should report diverging responses", func() {
+		divergences := counter("mirror_divergences_total")()
+		mirrorBody.Store(`{"status": "unhealthy"}`)
+
+		newMirror(100).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Eventually(counter("mirror_divergences_total")).Should(Equal(divergences + 1))
+	})
+
+	It("should not mirror write requests", func() {
+		newMirror(100).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", nil))
+
+		Consistently(mirrorCalls.Load, 100*time.Millisecond).Should(BeZero())
+	})
+
+	It("should compare the bodies of compressed responses", func() {
+		body := `{"results": [` + strings.Repeat(`{"display_name": "Small VM"},`, 100) + `{}]}`
+		large := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(body))
+		})
+		compressedMirror := httptest.NewServer(apiserver.Compress(1024)(large))
+		DeferCleanup(compressedMirror.Close)
+		mw, err := apiserver.Mirror(apiserver.MirrorOptions{Target: compressedMirror.URL, Percent: 100, Timeout: time.Second})
+		Expect(err).ToNot(HaveOccurred())
+		handler := apiserver.RequestID(apiserver.Compress(1024)(mw(large)))
+
+		mirrored := counter("mirror_requests_total")()
+		divergences := counter("mirror_divergences_total")()
+		for _, acceptEncoding := range []string{"", "gzip"} {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/catalog-items", nil)
+			if acceptEncoding != "" {
+				req.Header.Set("Accept-Encoding", acceptEncoding)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+		}
+
+		Eventually(counter("mirror_requests_total")).Should(Equal(mirrored + 2))
+		Consistently(counter("mirror_divergences_total"), 100*time.Millisecond).Should(Equal(divergences))
+	})
+
+	It("should not send credentials to the mirror", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/health", nil)
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("Cookie", "session=secret")
+		req.Header.Set("X-Forwarded-Client-Cert", "Hash=abc;Subject=\"CN=ci\"")
+		req.Header.Set("Accept", "application/json")
+
+		newMirror(100).ServeHTTP(httptest.NewRecorder(), req)
+
+		Eventually(mirrorCalls.Load).Should(BeEquivalentTo(1))
+		header := mirrorReq.Load().(http.Header)
+		Expect(header.Get("Accept")).To(Equal("application/json"))
+		Expect(header).ToNot(HaveKey("Authorization"))
+		Expect(header).ToNot(HaveKey("Cookie"))
+		Expect(header).ToNot(HaveKey("X-Forwarded-Client-Cert"))
+	})
+
+	It("should not mirror probes", func() {
+		for _, path := range []string{"/healthz", "/readyz"} {
+			newMirror(100).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+		}
+
+		Consistently(mirrorCalls.Load, 100*time.Millisecond).Should(BeZero())
+	})
+
+	It("should not mirror requests with a client identity", func() {
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/health", nil)
+		req = req.WithContext(auth.WithClientIdentity(req.Context(), auth.ClientIdentity{CommonName: "ci"}))
+
+		rec := httptest.NewRecorder()
+		newMirror(100).ServeHTTP(rec, req)
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Consistently(mirrorCalls.Load, 100*time.Millisecond).Should(BeZero())
+	})
+
+	It("should not mirror requests outside of the sample", func() {
+		newMirror(0).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Consistently(mirrorCalls.Load, 100*time.Millisecond).Should(BeZero())
+	})
+
+	DescribeTable("should reject invalid options",
+		func(opts apiserver.MirrorOptions, message string) {
+			_, err := apiserver.Mirror(opts)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("relative URL", apiserver.MirrorOptions{Target: "/canary", Percent: 10}, "must be an absolute URL"),
+		Entry("percent above 100", apiserver.MirrorOptions{Target: "http://canary", Percent: 150}, "between 0 and 100"),
+	)
+})
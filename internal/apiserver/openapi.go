@@ -0,0 +1,51 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+var docsTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}}</title>
+</head>
+<body>
+  <redoc spec-url="{{.SpecURL}}"></redoc>
+  <script src="https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js"></script>
+</body>
+</html>
+`))
+
+// openAPIHandler serves the embedded OpenAPI document as JSON.
+func openAPIHandler(swagger *openapi3.T) (http.HandlerFunc, error) {
+	body, err := json.Marshal(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal swagger spec: %w", err)
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(body)
+	}, nil
+}
+
+// docsHandler serves a Redoc page rendering the document served at specURL.
+func docsHandler(swagger *openapi3.T, specURL string) http.HandlerFunc {
+	data := struct {
+		Title   string
+		SpecURL string
+	}{
+		Title:   swagger.Info.Title,
+		SpecURL: specURL,
+	}
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = docsTemplate.Execute(w, data)
+	}
+}
@@ -0,0 +1,189 @@
+package apiserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+)
+
+// fieldsParameter is the query parameter listing the fields to return
+const fieldsParameter = "fields"
+
+// PartialResponse returns a middleware trimming the successful JSON
+// responses of the operations that accept the fields query parameter down
+// to the comma-separated field paths it lists, so that clients rendering a
+// few columns do not download whole resources. On list operations, whose
+// response holds the resources under results, the paths apply to each
+// resource and the other top-level fields are kept.
+//
+// The operation is identified by the route pattern, so the middleware must
+// be used on the routes of swagger mounted at baseURL.
+func PartialResponse(swagger *openapi3.T, baseURL string) func(http.Handler) http.Handler {
+	// Whether the operation lists resources, by method and route pattern
+	operations := map[string]bool{}
+	for path, item := range swagger.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if operation.Parameters.GetByInAndName(openapi3.ParameterInQuery, fieldsParameter) == nil {
+				continue
+			}
+			operations[method+" "+baseURL+path] = isListOperation(operation)
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			list, ok := operations[r.Method+" "+chi.RouteContext(r.Context()).RoutePattern()]
+			mask := parseFields(r.URL.Query()[fieldsParameter])
+			if !ok || mask == nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			bw := &bufferedWriter{ResponseWriter: w, status: http.StatusOK}
+			next.ServeHTTP(bw, r)
+
+			body := bw.body.Bytes()
+			mediaType, _, _ := mime.ParseMediaType(w.Header().Get("Content-Type"))
+			if bw.status == http.StatusOK && mediaType == "application/json" {
+				if projected, err := projectResponse(body, mask, list); err == nil {
+					body = projected
+					w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+				}
+			}
+			w.WriteHeader(bw.status)
+			_, _ = w.Write(body)
+		})
+	}
+}
+
+// isListOperation reports whether the successful response of operation is
+// a page of resources under results.
+func isListOperation(operation *openapi3.Operation) bool {
+	response := operation.Responses.Status(http.StatusOK)
+	if response == nil || response.Value == nil {
+		return false
+	}
+	content := response.Value.Content.Get("application/json")
+	if content == nil || content.Schema == nil || content.Schema.Value == nil {
+		return false
+	}
+	results, ok := content.Schema.Value.Properties["results"]
+	return ok && results.Value != nil && results.Value.Type.Is(openapi3.TypeArray)
+}
+
+// fieldMask is the tree of the requested field paths. A nil subtree selects
+// the whole field.
+type fieldMask map[string]fieldMask
+
+// parseFields builds the mask of the comma-separated field paths in values.
+// It returns nil when no path is listed.
+func parseFields(values []string) fieldMask {
+	var mask fieldMask
+	for _, value := range values {
+		for _, path := range strings.Split(value, ",") {
+			path = strings.TrimSpace(path)
+			if path == "" {
+				continue
+			}
+			if mask == nil {
+				mask = fieldMask{}
+			}
+			mask.add(strings.Split(path, "."))
+		}
+	}
+	return mask
+}
+
+func (m fieldMask) add(segments []string) {
+	sub, ok := m[segments[0]]
+	if ok && sub == nil {
+		// The whole field is already selected
+		return
+	}
+	if len(segments) == 1 {
+		m[segments[0]] = nil
+		return
+	}
+	if sub == nil {
+		sub = fieldMask{}
+		m[segments[0]] = sub
+	}
+	sub.add(segments[1:])
+}
+
+// apply returns the fields of value selected by m. Arrays are projected
+// element by element.
+func (m fieldMask) apply(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		projected := map[string]any{}
+		for key, sub := range m {
+			field, ok := v[key]
+			if !ok {
+				continue
+			}
+			if sub == nil {
+				projected[key] = field
+			} else {
+				projected[key] = sub.apply(field)
+			}
+		}
+		return projected
+	case []any:
+		projected := make([]any, len(v))
+		for i, item := range v {
+			projected[i] = m.apply(item)
+		}
+		return projected
+	default:
+		return value
+	}
+}
+
+// projectResponse applies mask to the resource in body or, for list
+// responses, to each resource in its results.
+func projectResponse(body []byte, mask fieldMask, list bool) ([]byte, error) {
+	var document map[string]any
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, err
+	}
+	if list {
+		if results, ok := document["results"]; ok {
+			document["results"] = mask.apply(results)
+		}
+	} else {
+		document = mask.apply(document).(map[string]any)
+	}
+	projected, err := json.Marshal(document)
+	if err != nil {
+		return nil, err
+	}
+	return append(projected, '\n'), nil
+}
+
+// bufferedWriter holds the status and body of a response until they have
+// been projected.
+type bufferedWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func (w *bufferedWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.status = code
+	}
+}
+
+func (w *bufferedWriter) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	return w.body.Write(b)
+}
@@ -0,0 +1,96 @@
+package apiserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/go-chi/chi/v5"
+)
+
+var _ = Describe("PartialResponse", func() {
+	const (
+		catalogItem = `{"uid": "6f1c", "display_name": "Small VM", "path": "catalog-items/small-vm",
+			"spec": {"service_type": "vm", "fields": [{"path": "spec.vcpu.count", "default": 2}]}}`
+		catalogItemList = `{"results": [` + catalogItem + `], "next_page_token": "abc"}`
+	)
+
+	var router chi.Router
+
+	BeforeEach(func() {
+		swagger, err := v1alpha1.GetSwagger()
+		Expect(err).ToNot(HaveOccurred())
+
+		respond := func(body string) http.HandlerFunc {
+			return func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				_, _ = w.Write([]byte(body))
+			}
+		}
+		router = chi.NewRouter()
+		router.Group(func(r chi.Router) {
+			r.Use(apiserver.PartialResponse(swagger, "/api/v1alpha1"))
+			r.Get("/api/v1alpha1/catalog-items", respond(catalogItemList))
+			r.Get("/api/v1alpha1/catalog-items/{catalogItemId}", func(w http.ResponseWriter, r *http.Request) {
+				if chi.URLParam(r, "catalogItemId") != "small-vm" {
+					w.Header().Set("Content-Type", "application/json")
+					w.WriteHeader(http.StatusNotFound)
+					_, _ = w.Write([]byte(`{"type": "NOT_FOUND", "status": 404, "title": "Resource not found"}`))
+					return
+				}
+				respond(catalogItem)(w, r)
+			})
+			r.Get("/api/v1alpha1/version", respond(`{"version": "1.0.0", "git_commit": "abc"}`))
+		})
+	})
+
+	get := func(target string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+		return rec
+	}
+
+	It("should return only the requested fields of a resource", func() {
+		rec := get("/api/v1alpha1/catalog-items/small-vm?fields=uid,spec.service_type")
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(MatchJSON(`{"uid": "6f1c", "spec": {"service_type": "vm"}}`))
+	})
+
+	It("should project each resource of a list and keep the page token", func() {
+		rec := get("/api/v1alpha1/catalog-items?fields=display_name,spec.fields.path")
+
+		Expect(rec.Body.String()).To(MatchJSON(`{
+			"results": [{"display_name": "Small VM", "spec": {"fields": [{"path": "spec.vcpu.count"}]}}],
+			"next_page_token": "abc"
+		}`))
+	})
+
+	It("should ignore unknown paths", func() {
+		rec := get("/api/v1alpha1/catalog-items/small-vm?fields=uid,colour,spec.size.gb")
+
+		Expect(rec.Body.String()).To(MatchJSON(`{"uid": "6f1c", "spec": {}}`))
+	})
+
+	It("should return the whole resource when no field is requested", func() {
+		Expect(get("/api/v1alpha1/catalog-items/small-vm").Body.String()).To(MatchJSON(catalogItem))
+		Expect(get("/api/v1alpha1/catalog-items/small-vm?fields=").Body.String()).To(MatchJSON(catalogItem))
+	})
+
+	It("should leave operations without the fields parameter alone", func() {
+		rec := get("/api/v1alpha1/version?fields=version")
+
+		Expect(rec.Body.String()).To(MatchJSON(`{"version": "1.0.0", "git_commit": "abc"}`))
+	})
+
+	It("should leave unsuccessful responses alone", func() {
+		rec := get("/api/v1alpha1/catalog-items/large-vm?fields=uid")
+
+		Expect(rec.Code).To(Equal(http.StatusNotFound))
+		Expect(rec.Body.String()).To(MatchJSON(`{"type": "NOT_FOUND", "status": 404, "title": "Resource not found"}`))
+	})
+})
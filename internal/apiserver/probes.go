@@ -0,0 +1,59 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+const (
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+)
+
+type probeResponse struct {
+	Status string            `json:"status"`
+	Checks map[string]string `json:"checks,omitempty"`
+}
+
+// livenessHandler only reports that the process is serving requests; it must
+// stay cheap and never depend on downstream services.
+func livenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeProbe(w, http.StatusOK, probeResponse{Status: "ok"})
+}
+
+// readinessHandler runs the dependency checks and fails with 503 when any of
+// them does, or once the server is draining, so that traffic is routed away
+// from this replica.
+func readinessHandler(checker *health.Checker, draining *atomic.Bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if draining.Load() {
+			writeProbe(w, http.StatusServiceUnavailable, probeResponse{Status: "draining"})
+			return
+		}
+
+		results := checker.Run(r.Context())
+
+		response := probeResponse{Status: "ok", Checks: map[string]string{}}
+		status := http.StatusOK
+		for _, result := range results {
+			if result.Err != nil {
+				response.Checks[result.Name] = result.Err.Error()
+				response.Status = "unavailable"
+				status = http.StatusServiceUnavailable
+			} else {
+				response.Checks[result.Name] = "ok"
+			}
+		}
+		writeProbe(w, status, response)
+	}
+}
+
+func writeProbe(w http.ResponseWriter, status int, response probeResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,79 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/timing"
+)
+
+const (
+	processingTimeHeader = "X-Processing-Time"
+	serverTimingHeader   = "Server-Timing"
+)
+
+// ProcessingTime reports how long the server spent on a request, in
+// milliseconds, in the X-Processing-Time response header. When breakdown is
+// set, the time recorded per layer is also reported in a Server-Timing header.
+func ProcessingTime(breakdown bool) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx, recorder := timing.WithRecorder(r.Context())
+			tw := &timingWriter{
+				ResponseWriter: w,
+				start:          time.Now(),
+				recorder:       recorder,
+				breakdown:      breakdown,
+			}
+
+			next.ServeHTTP(tw, r.WithContext(ctx))
+
+			if !tw.wroteHeader {
+				tw.WriteHeader(http.StatusOK)
+			}
+		})
+	}
+}
+
+// trackHandlerTime records the time spent in the strict handler implementation.
+func trackHandlerTime(f server.StrictHandlerFunc, operationID string) server.StrictHandlerFunc {
+	return func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		defer timing.Track(ctx, "handler")()
+		return f(ctx, w, r, request)
+	}
+}
+
+// timingWriter sets the timing headers right before the status line is sent.
+type timingWriter struct {
+	http.ResponseWriter
+	start       time.Time
+	recorder    *timing.Recorder
+	breakdown   bool
+	wroteHeader bool
+}
+
+func (w *timingWriter) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.Header().Set(processingTimeHeader, timing.Milliseconds(time.Since(w.start)))
+		if w.breakdown {
+			if value := w.recorder.ServerTiming(); value != "" {
+				w.Header().Set(serverTimingHeader, value)
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *timingWriter) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *timingWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
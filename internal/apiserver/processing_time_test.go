@@ -0,0 +1,48 @@
+package apiserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/timing"
+)
+
+var _ = Describe("ProcessingTime", func() {
+	layered := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stop := timing.Track(r.Context(), "store")
+		stop()
+		w.WriteHeader(http.StatusCreated)
+	})
+
+	It("should set the X-Processing-Time header in milliseconds", func() {
+		rec := httptest.NewRecorder()
+		apiserver.ProcessingTime(false)(layered).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusCreated))
+		value, err := strconv.ParseFloat(rec.Header().Get("X-Processing-Time"), 64)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(BeNumerically(">=", 0))
+		Expect(rec.Header().Get("Server-Timing")).To(BeEmpty())
+	})
+
+	It("should report the per-layer breakdown when enabled", func() {
+		rec := httptest.NewRecorder()
+		apiserver.ProcessingTime(true)(layered).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(rec.Header().Get("Server-Timing")).To(MatchRegexp(`^store;dur=[0-9.]+$`))
+	})
+
+	It("should set the header when the handler writes no response", func() {
+		rec := httptest.NewRecorder()
+		apiserver.ProcessingTime(false)(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {})).
+			ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("X-Processing-Time")).ToNot(BeEmpty())
+	})
+})
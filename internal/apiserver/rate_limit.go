@@ -0,0 +1,168 @@
+package apiserver
+
+import (
+	"expvar"
+	"fmt"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/auth"
+	"github.com/go-chi/chi/v5/middleware"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// idleLimiterTTL is how long the bucket of an idle client is kept; an
+	// expired bucket is as full as a new one, so dropping it is harmless
+	idleLimiterTTL = 10 * time.Minute
+)
+
+// rateLimitedTotal counts the requests rejected by the rate limiter.
+var rateLimitedTotal = expvar.NewInt("rate_limited_total")
+
+// RateLimit is the sustained rate, in requests per second, and the burst
+// allowed per client for a class of requests. A zero Rate disables it.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type RateLimitOptions struct {
+	// Reads applies to GET, HEAD and OPTIONS requests
+	Reads RateLimit
+	// Writes applies to every other request
+	Writes RateLimit
+	// ExcludePaths are request paths that are never limited, e.g. probes
+	ExcludePaths []string
+}
+
+// RateLimiter returns a middleware giving each client, identified by its
+// client certificate or else by its IP address, a token bucket per class of
+// requests. Requests exceeding it are rejected with 429 and a Retry-After
+// header.
+func RateLimiter(opts RateLimitOptions) func(http.Handler) http.Handler {
+	return newRateLimiter(opts).middleware
+}
+
+// rateLimiter holds the token buckets of the clients; the limits can be
+// replaced while serving.
+type rateLimiter struct {
+	reads        *limiterSet
+	writes       *limiterSet
+	excludePaths []string
+}
+
+func newRateLimiter(opts RateLimitOptions) *rateLimiter {
+	return &rateLimiter{
+		reads:        newLimiterSet(opts.Reads),
+		writes:       newLimiterSet(opts.Writes),
+		excludePaths: opts.ExcludePaths,
+	}
+}
+
+// setLimits replaces the limits, including those of the existing buckets.
+func (l *rateLimiter) setLimits(reads, writes RateLimit) {
+	l.reads.setLimit(reads)
+	l.writes.setLimit(writes)
+}
+
+func (l *rateLimiter) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slices.Contains(l.excludePaths, r.URL.Path) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		limiters := l.writes
+		switch r.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions:
+			limiters = l.reads
+		}
+
+		client := clientIP(r)
+		if identity, ok := auth.ClientIdentityFromContext(r.Context()); ok {
+			client = "cn:" + identity.CommonName
+		}
+		if delay, limit, ok := limiters.allow(client); !ok {
+			rateLimitedTotal.Add(1)
+			w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(delay.Seconds()))))
+			writeProblem(w, http.StatusTooManyRequests, v1alpha1.RESOURCEEXHAUSTED, "Too many requests",
+				fmt.Sprintf("Rate limit of %g requests per second exceeded", limit.Rate),
+				middleware.GetReqID(r.Context()))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+type clientLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// limiterSet holds the token buckets of the clients for a class of requests.
+type limiterSet struct {
+	mu        sync.Mutex
+	limit     RateLimit
+	clients   map[string]*clientLimiter
+	lastSweep time.Time
+}
+
+func newLimiterSet(limit RateLimit) *limiterSet {
+	return &limiterSet{
+		limit:     limit,
+		clients:   map[string]*clientLimiter{},
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *limiterSet) setLimit(limit RateLimit) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.limit = limit
+	now := time.Now()
+	for _, c := range s.clients {
+		c.limiter.SetLimitAt(now, rate.Limit(limit.Rate))
+		c.limiter.SetBurstAt(now, max(limit.Burst, 1))
+	}
+}
+
+// allow takes a token from the bucket of client, or returns how long the
+// client should wait for one under the current limit.
+func (s *limiterSet) allow(client string) (time.Duration, RateLimit, bool) {
+	now := time.Now()
+	s.mu.Lock()
+	limit := s.limit
+	if limit.Rate <= 0 {
+		s.mu.Unlock()
+		return 0, limit, true
+	}
+	if now.Sub(s.lastSweep) > idleLimiterTTL {
+		for key, c := range s.clients {
+			if now.Sub(c.lastSeen) > idleLimiterTTL {
+				delete(s.clients, key)
+			}
+		}
+		s.lastSweep = now
+	}
+	c, ok := s.clients[client]
+	if !ok {
+		c = &clientLimiter{limiter: rate.NewLimiter(rate.Limit(limit.Rate), max(limit.Burst, 1))}
+		s.clients[client] = c
+	}
+	c.lastSeen = now
+	s.mu.Unlock()
+
+	reservation := c.limiter.ReserveN(now, 1)
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+		return delay, limit, false
+	}
+	return 0, limit, true
+}
@@ -0,0 +1,74 @@
+package apiserver_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/auth"
+)
+
+var _ = Describe("RateLimiter", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		handler = apiserver.RateLimiter(apiserver.RateLimitOptions{
+			Reads:        apiserver.RateLimit{Rate: 1, Burst: 2},
+			Writes:       apiserver.RateLimit{Rate: 0.1, Burst: 1},
+			ExcludePaths: []string{"/healthz"},
+		})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	})
+
+	send := func(method, path, remoteAddr string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.RemoteAddr = remoteAddr
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+
+	It("should reject requests beyond the burst with a Retry-After header", func() {
+		Expect(send(http.MethodGet, "/", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		Expect(send(http.MethodGet, "/", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+
+		rec := send(http.MethodGet, "/", "10.0.0.1:1234")
+		Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+		Expect(rec.Header().Get("Retry-After")).To(Equal("1"))
+		Expect(rec.Body.String()).To(ContainSubstring("RESOURCE_EXHAUSTED"))
+	})
+
+	It("should limit reads and writes separately", func() {
+		Expect(send(http.MethodPost, "/", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+
+		rec := send(http.MethodPost, "/", "10.0.0.1:1234")
+		Expect(rec.Code).To(Equal(http.StatusTooManyRequests))
+		Expect(rec.Header().Get("Retry-After")).To(Equal("10"))
+
+		Expect(send(http.MethodGet, "/", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+	})
+
+	It("should limit each client separately", func() {
+		Expect(send(http.MethodPost, "/", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		Expect(send(http.MethodPost, "/", "10.0.0.2:1234").Code).To(Equal(http.StatusOK))
+	})
+
+	It("should identify clients by their certificate when available", func() {
+		for _, cn := range []string{"ci", "ops"} {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			req = req.WithContext(auth.WithClientIdentity(req.Context(), auth.ClientIdentity{CommonName: cn}))
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			Expect(rec.Code).To(Equal(http.StatusOK))
+		}
+	})
+
+	It("should not limit excluded paths", func() {
+		for range 5 {
+			Expect(send(http.MethodGet, "/healthz", "10.0.0.1:1234").Code).To(Equal(http.StatusOK))
+		}
+	})
+})
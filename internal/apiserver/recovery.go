@@ -0,0 +1,65 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// panicsTotal counts the panics recovered while serving requests.
+var panicsTotal = expvar.NewInt("panics_total")
+
+// Recoverer recovers from panics raised by downstream handlers, logs the
+// stack trace and answers with a 500 problem+json response carrying the
+// request ID, instead of letting the connection be torn down.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			rvr := recover()
+			if rvr == nil {
+				return
+			}
+			if rvr == http.ErrAbortHandler {
+				// Let net/http abort the response as intended
+				panic(rvr)
+			}
+
+			panicsTotal.Add(1)
+			logging.FromContext(r.Context()).Error("Recovered from panic",
+				"method", r.Method, "path", r.URL.Path, "panic", rvr, "stack", string(debug.Stack()))
+
+			if r.Header.Get("Connection") == "Upgrade" {
+				return
+			}
+			writeProblem(w, http.StatusInternalServerError, v1alpha1.INTERNAL,
+				"Internal server error", "An unexpected error occurred while processing the request",
+				middleware.GetReqID(r.Context()))
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// writeProblem writes an RFC 7807 problem details response.
+func writeProblem(w http.ResponseWriter, status int, errType v1alpha1.ErrorType, title, detail, instance string) {
+	problem := v1alpha1.Error{
+		Type:   errType,
+		Status: int32(status),
+		Title:  title,
+	}
+	if detail != "" {
+		problem.Detail = &detail
+	}
+	if instance != "" {
+		problem.Instance = &instance
+	}
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(problem)
+}
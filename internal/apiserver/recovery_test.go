@@ -0,0 +1,63 @@
+package apiserver_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+)
+
+var _ = Describe("Recoverer", func() {
+	panicking := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic("boom")
+	})
+
+	It("should convert a panic into a problem response with the request ID", func() {
+		handler := apiserver.RequestID(apiserver.Recoverer(panicking))
+		panics := expvar.Get("panics_total").(*expvar.Int).Value()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1alpha1/health", nil)
+		req.Header.Set(apiserver.RequestIDHeader, "req-123")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		Expect(rec.Code).To(Equal(http.StatusInternalServerError))
+		Expect(rec.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+
+		var problem v1alpha1.Error
+		Expect(json.Unmarshal(rec.Body.Bytes(), &problem)).To(Succeed())
+		Expect(problem.Type).To(Equal(v1alpha1.INTERNAL))
+		Expect(problem.Status).To(Equal(int32(http.StatusInternalServerError)))
+		Expect(problem.Instance).ToNot(BeNil())
+		Expect(*problem.Instance).To(Equal("req-123"))
+
+		Expect(expvar.Get("panics_total").(*expvar.Int).Value()).To(Equal(panics + 1))
+	})
+
+	It("should pass through requests that do not panic", func() {
+		handler := apiserver.Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}))
+
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusNoContent))
+	})
+
+	It("should re-panic on http.ErrAbortHandler", func() {
+		handler := apiserver.Recoverer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		}))
+
+		Expect(func() {
+			handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+		}).To(PanicWith(http.ErrAbortHandler))
+	})
+})
@@ -0,0 +1,139 @@
+package apiserver_test
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	handlers "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+var _ = Describe("Reload", func() {
+	var (
+		cfg     *config.Config
+		srv     *apiserver.Server
+		baseURL string
+	)
+
+	start := func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		readiness := health.NewChecker(cfg.ReadinessTimeout)
+		routes := apiserver.NewRoutingTable(apiserver.V1Alpha1(handlers.NewHandler(readiness)))
+		srv = apiserver.New(cfg, listener, routes, readiness)
+		baseURL = runServer(srv, listener)
+	}
+
+	// Do not let connections dialed but left unused delay the shutdown
+	client := &http.Client{Transport: &http.Transport{DisableKeepAlives: true}}
+
+	reloaded := func(update func(*config.Config)) *config.Config {
+		next := *cfg
+		update(&next)
+		return &next
+	}
+
+	BeforeEach(func() {
+		cfg = &config.Config{ReadinessTimeout: time.Second}
+	})
+
+	It("should apply new rate limits", func() {
+		cfg.RateLimitEnabled = true
+		cfg.RateLimitReadRate = 100
+		cfg.RateLimitReadBurst = 100
+		start()
+
+		Expect(srv.Reload(reloaded(func(c *config.Config) {
+			c.RateLimitReadRate = 0.1
+			c.RateLimitReadBurst = 1
+		}))).To(Succeed())
+
+		resp, err := client.Get(baseURL + "/api/v1alpha1/health")
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+		resp, err = client.Get(baseURL + "/api/v1alpha1/health")
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("should apply new CORS allowed origins", func() {
+		cfg.CORSAllowedOrigins = []string{"https://old.example.com"}
+		cfg.CORSAllowedMethods = []string{"GET"}
+		start()
+
+		Expect(srv.Reload(reloaded(func(c *config.Config) {
+			c.CORSAllowedOrigins = []string{"https://new.example.com"}
+		}))).To(Succeed())
+
+		allowOrigin := func(origin string) string {
+			req, err := http.NewRequest(http.MethodGet, baseURL+"/api/v1alpha1/health", nil)
+			Expect(err).ToNot(HaveOccurred())
+			req.Header.Set("Origin", origin)
+			resp, err := client.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			return resp.Header.Get("Access-Control-Allow-Origin")
+		}
+		Expect(allowOrigin("https://new.example.com")).To(Equal("https://new.example.com"))
+		Expect(allowOrigin("https://old.example.com")).To(BeEmpty())
+	})
+
+	Context("with TLS", func() {
+		var oldCert, newCert *testCert
+
+		get := func(trusted *testCert) error {
+			resp, err := httpsClient(trusted).Get(httpsURL(baseURL) + "/api/v1alpha1/health")
+			if err == nil {
+				resp.Body.Close()
+			}
+			return err
+		}
+
+		BeforeEach(func() {
+			oldCert = validTestCert("localhost")
+			newCert = validTestCert("localhost")
+			cfg.TLSCert = string(oldCert.CertPEM)
+			cfg.TLSKey = string(oldCert.KeyPEM)
+			start()
+			// Reload only applies to a running server
+			Eventually(func() error { return get(oldCert) }).Should(Succeed())
+		})
+
+		It("should serve the new certificate to new connections", func() {
+			Expect(srv.Reload(reloaded(func(c *config.Config) {
+				c.TLSCert = string(newCert.CertPEM)
+				c.TLSKey = string(newCert.KeyPEM)
+			}))).To(Succeed())
+
+			Expect(get(newCert)).To(Succeed())
+			Expect(get(oldCert)).ToNot(Succeed())
+		})
+
+		It("should keep the current certificate when the new one is invalid", func() {
+			err := srv.Reload(reloaded(func(c *config.Config) {
+				c.TLSCert = string(newCert.CertPEM)
+				c.TLSKey = string(oldCert.KeyPEM)
+			}))
+			Expect(err).To(MatchError(ContainSubstring("invalid TLS certificate or key")))
+
+			Expect(get(oldCert)).To(Succeed())
+		})
+
+		It("should refuse to disable TLS", func() {
+			err := srv.Reload(reloaded(func(c *config.Config) {
+				c.TLSCert = ""
+				c.TLSKey = ""
+			}))
+			Expect(err).To(MatchError(ContainSubstring("TLS cannot be disabled without a restart")))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package apiserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+// MaxBodySize returns a middleware rejecting request bodies larger than
+// limit bytes with 413, instead of letting decoding fail on a truncated
+// body.
+func MaxBodySize(limit int64) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Body == nil || r.Body == http.NoBody {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			tooLarge := r.ContentLength > limit
+			if !tooLarge {
+				// The Content-Length header is missing for chunked bodies
+				body, err := io.ReadAll(io.LimitReader(r.Body, limit+1))
+				if err != nil {
+					writeProblem(w, http.StatusBadRequest, v1alpha1.INVALIDARGUMENT, "Invalid request body",
+						fmt.Sprintf("Failed to read request body: %v", err), middleware.GetReqID(r.Context()))
+					return
+				}
+				tooLarge = int64(len(body)) > limit
+				r.Body = io.NopCloser(bytes.NewReader(body))
+			}
+			if tooLarge {
+				writeProblem(w, http.StatusRequestEntityTooLarge, v1alpha1.INVALIDARGUMENT, "Request body too large",
+					fmt.Sprintf("Request body exceeds the limit of %d bytes", limit), middleware.GetReqID(r.Context()))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// APIVersion returns a middleware rejecting JSON request bodies whose
+// api_version field does not match version, the version of the API in the
+// URL. Bodies without the field are left to the handlers.
+func APIVersion(version string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if r.Body == nil || r.Body == http.NoBody || !strings.HasSuffix(mediaType, "json") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			var document struct {
+				APIVersion *string `json:"api_version"`
+			}
+			// Malformed bodies are reported by the handler
+			if json.Unmarshal(body, &document) == nil && document.APIVersion != nil && *document.APIVersion != version {
+				writeProblem(w, http.StatusBadRequest, v1alpha1.INVALIDARGUMENT, "Invalid api_version",
+					fmt.Sprintf("Field api_version is '%s' but the request targets '%s'", *document.APIVersion, version),
+					middleware.GetReqID(r.Context()))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package apiserver_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+var _ = Describe("MaxBodySize", func() {
+	var (
+		handler  http.Handler
+		received string
+	)
+
+	BeforeEach(func() {
+		received = ""
+		handler = apiserver.MaxBodySize(8)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received = string(body)
+		}))
+	})
+
+	It("should pass bodies within the limit", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("12345678")))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(received).To(Equal("12345678"))
+	})
+
+	It("should reject bodies declared larger than the limit", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader("123456789")))
+
+		Expect(rec.Code).To(Equal(http.StatusRequestEntityTooLarge))
+		Expect(rec.Body.String()).To(ContainSubstring("limit of 8 bytes"))
+	})
+
+	It("should reject chunked bodies larger than the limit", func() {
+		req := httptest.NewRequest(http.MethodPost, "/", io.MultiReader(strings.NewReader("12345"), strings.NewReader("6789")))
+		req.ContentLength = -1
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		Expect(rec.Code).To(Equal(http.StatusRequestEntityTooLarge))
+		Expect(received).To(BeEmpty())
+	})
+})
+
+var _ = Describe("APIVersion", func() {
+	var baseURL string
+
+	BeforeEach(func() {
+		baseURL = startServer(&config.Config{ReadinessTimeout: time.Second})
+	})
+
+	post := func(body string) (int, string) {
+		resp, err := http.Post(baseURL+"/api/v1alpha1/catalog-items", "application/json", strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		problem, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		return resp.StatusCode, string(problem)
+	}
+
+	It("should reject an api_version that does not match the URL", func() {
+		status, problem := post(`{"api_version": "v1beta1", "display_name": "Small VM"}`)
+
+		Expect(status).To(Equal(http.StatusBadRequest))
+		Expect(problem).To(ContainSubstring("Field api_version is 'v1beta1' but the request targets 'v1alpha1'"))
+	})
+
+	It("should accept a matching api_version", func() {
+		status, problem := post(`{"api_version": "v1alpha1", "display_name": "Small VM"}`)
+
+		Expect(status).ToNot(Equal(http.StatusBadRequest))
+		Expect(problem).To(ContainSubstring("UNIMPLEMENTED"))
+	})
+})
@@ -0,0 +1,36 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/dcm-project/catalog-manager/internal/logging"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+)
+
+const (
+	RequestIDHeader = "X-Request-ID"
+
+	// maxRequestIDLength bounds client-provided IDs, which end up in every log line
+	maxRequestIDLength = 128
+)
+
+// RequestID assigns each request an ID, taken from the X-Request-ID header
+// when present or generated otherwise. The ID is echoed in the response,
+// stored in the context (see middleware.GetReqID), and attached to the
+// context logger so every log line of the request can be correlated.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get(RequestIDHeader)
+		if id == "" || len(id) > maxRequestIDLength {
+			id = uuid.NewString()
+		}
+
+		ctx := context.WithValue(r.Context(), middleware.RequestIDKey, id)
+		ctx = logging.WithLogger(ctx, logging.FromContext(ctx).With("request_id", id))
+		w.Header().Set(RequestIDHeader, id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
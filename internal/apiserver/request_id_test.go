@@ -0,0 +1,64 @@
+package apiserver_test
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+var _ = Describe("RequestID", func() {
+	var (
+		seenID string
+		logs   *bytes.Buffer
+		before *slog.Logger
+	)
+
+	handler := apiserver.RequestID(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seenID = middleware.GetReqID(r.Context())
+		logging.FromContext(r.Context()).Info("handled")
+	}))
+
+	BeforeEach(func() {
+		seenID = ""
+		logs = &bytes.Buffer{}
+		before = slog.Default()
+		slog.SetDefault(slog.New(slog.NewTextHandler(logs, nil)))
+		DeferCleanup(func() { slog.SetDefault(before) })
+	})
+
+	It("should propagate the client-provided request ID", func() {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(apiserver.RequestIDHeader, "abc-123")
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+
+		Expect(seenID).To(Equal("abc-123"))
+		Expect(rec.Header().Get(apiserver.RequestIDHeader)).To(Equal("abc-123"))
+		Expect(logs.String()).To(ContainSubstring("request_id=abc-123"))
+	})
+
+	It("should generate a request ID when none is provided", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		Expect(seenID).ToNot(BeEmpty())
+		Expect(rec.Header().Get(apiserver.RequestIDHeader)).To(Equal(seenID))
+	})
+
+	It("should replace oversized request IDs", func() {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(apiserver.RequestIDHeader, strings.Repeat("x", 1000))
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+
+		Expect(len(seenID)).To(BeNumerically("<=", 128))
+	})
+})
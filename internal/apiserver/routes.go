@@ -0,0 +1,43 @@
+package apiserver
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi/v5"
+	strictnethttp "github.com/oapi-codegen/runtime/strictmiddleware/nethttp"
+)
+
+// Group is a set of API routes, e.g. one version of the API or an
+// integration, registered with its own middlewares.
+type Group struct {
+	Name string
+	// Middlewares only apply to the routes of the group, after the
+	// server-wide ones, e.g. for group specific authentication or limits
+	Middlewares []func(http.Handler) http.Handler
+	// Register adds the routes of the group to router, with absolute paths
+	Register func(router chi.Router, opts GroupOptions) error
+}
+
+// GroupOptions are the server-wide settings that groups register with.
+type GroupOptions struct {
+	// StrictMiddlewares must wrap the generated strict handlers of the group
+	StrictMiddlewares []strictnethttp.StrictHTTPMiddlewareFunc
+	APIDocsEnabled    bool
+	// UnknownFields is the default handling of unknown fields in request
+	// bodies, UnknownFieldsStrict or UnknownFieldsLenient
+	UnknownFields string
+}
+
+// RoutingTable lists the groups served by the API server.
+type RoutingTable struct {
+	groups []Group
+}
+
+func NewRoutingTable(groups ...Group) *RoutingTable {
+	return &RoutingTable{groups: groups}
+}
+
+// Add registers group on the routing table.
+func (t *RoutingTable) Add(group Group) {
+	t.groups = append(t.groups, group)
+}
@@ -0,0 +1,85 @@
+package apiserver_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	handlers "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/health"
+	"github.com/go-chi/chi/v5"
+)
+
+var _ = Describe("RoutingTable", func() {
+	var (
+		cfg       *config.Config
+		readiness *health.Checker
+		listener  net.Listener
+	)
+
+	// integration is a group with its own middleware, tagging its responses
+	integration := apiserver.Group{
+		Name: "integration",
+		Middlewares: []func(http.Handler) http.Handler{
+			func(next http.Handler) http.Handler {
+				return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Header().Set("X-Group", "integration")
+					next.ServeHTTP(w, r)
+				})
+			},
+		},
+		Register: func(router chi.Router, opts apiserver.GroupOptions) error {
+			router.Get("/integration/v2/catalog", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, "catalog")
+			})
+			return nil
+		},
+	}
+
+	BeforeEach(func() {
+		cfg = &config.Config{ReadinessTimeout: time.Second}
+		readiness = health.NewChecker(cfg.ReadinessTimeout)
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should serve every group with its own middlewares", func() {
+		routes := apiserver.NewRoutingTable(apiserver.V1Alpha1(handlers.NewHandler(readiness)))
+		routes.Add(integration)
+		baseURL := runServer(apiserver.New(cfg, listener, routes, readiness), listener)
+
+		resp, err := http.Get(baseURL + "/integration/v2/catalog")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("X-Group")).To(Equal("integration"))
+
+		resp, err = http.Get(baseURL + "/api/v1alpha1/health")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("X-Group")).To(BeEmpty())
+	})
+
+	It("should fail to start when a group fails to register", func() {
+		routes := apiserver.NewRoutingTable(apiserver.Group{
+			Name: "broken",
+			Register: func(chi.Router, apiserver.GroupOptions) error {
+				return errors.New("missing spec")
+			},
+		})
+		DeferCleanup(listener.Close)
+
+		err := apiserver.New(cfg, listener, routes, readiness).Run(context.Background())
+		Expect(err).To(MatchError("failed to register the broken routes: missing spec"))
+	})
+})
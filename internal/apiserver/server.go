@@ -2,75 +2,268 @@ package apiserver
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"net/http"
+	"os"
+	"sync/atomic"
 	"time"
 
-	"github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
 	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/health"
+	"github.com/dcm-project/catalog-manager/internal/ui"
 	"github.com/go-chi/chi/v5"
-	"github.com/go-chi/chi/v5/middleware"
 )
 
+// gracefulShutdownTimeout is the default time given to in-flight requests
+// to complete on shutdown
 const gracefulShutdownTimeout = 5 * time.Second
 
 type Server struct {
-	config   *config.Config
-	listener net.Listener
-	handler  server.StrictServerInterface
+	config    *config.Config
+	listener  net.Listener
+	routes    *RoutingTable
+	readiness *health.Checker
+	draining  atomic.Bool
+
+	// Settings that Reload can change while serving
+	cors        *cors
+	rateLimiter *rateLimiter
+	certificate atomic.Pointer[tls.Certificate]
 }
 
-func New(cfg *config.Config, listener net.Listener, handler server.StrictServerInterface) *Server {
-	return &Server{
-		config:   cfg,
-		listener: listener,
-		handler:  handler,
+// New creates an API server serving the groups of routes. The readiness
+// probe fails whenever one of the checks registered on readiness does.
+func New(cfg *config.Config, listener net.Listener, routes *RoutingTable, readiness *health.Checker) *Server {
+	s := &Server{
+		config:    cfg,
+		listener:  listener,
+		routes:    routes,
+		readiness: readiness,
+	}
+	if len(cfg.CORSAllowedOrigins) > 0 {
+		s.cors = newCORS(CORSOptions{
+			AllowedOrigins: cfg.CORSAllowedOrigins,
+			AllowedMethods: cfg.CORSAllowedMethods,
+			AllowedHeaders: cfg.CORSAllowedHeaders,
+			MaxAge:         cfg.CORSMaxAge,
+		})
 	}
+	if cfg.RateLimitEnabled {
+		reads, writes := rateLimits(cfg)
+		s.rateLimiter = newRateLimiter(RateLimitOptions{
+			Reads:        reads,
+			Writes:       writes,
+			ExcludePaths: []string{livenessPath, readinessPath},
+		})
+	}
+	return s
+}
+
+// Reload applies the settings of cfg that can change without restarting:
+// the rate limits, the CORS allowed origins and the TLS certificate. It
+// only updates the features enabled at startup; other settings, including
+// enabling or disabling a feature, are ignored until the next restart.
+// In-flight requests and open connections are not affected.
+func (s *Server) Reload(cfg *config.Config) error {
+	if s.certificate.Load() != nil {
+		cert, err := loadCertificate(cfg)
+		if err != nil {
+			return err
+		}
+		if cert == nil {
+			return errors.New("TLS cannot be disabled without a restart")
+		}
+		s.certificate.Store(cert)
+	}
+	if s.cors != nil {
+		s.cors.setAllowedOrigins(cfg.CORSAllowedOrigins)
+	}
+	if s.rateLimiter != nil {
+		s.rateLimiter.setLimits(rateLimits(cfg))
+	}
+	return nil
+}
+
+func rateLimits(cfg *config.Config) (RateLimit, RateLimit) {
+	return RateLimit{Rate: cfg.RateLimitReadRate, Burst: cfg.RateLimitReadBurst},
+		RateLimit{Rate: cfg.RateLimitWriteRate, Burst: cfg.RateLimitWriteBurst}
+}
+
+// ValidateConfig checks the settings of cfg that Run would refuse to start
+// with, given tlsConfig as returned by NewTLSConfig, so that they can be
+// checked without serving.
+func ValidateConfig(cfg *config.Config, tlsConfig *tls.Config) error {
+	if cfg.AccessLogEnabled {
+		if err := validateAccessLog(cfg.AccessLogFormat, cfg.AccessLogFields); err != nil {
+			return err
+		}
+	}
+	if cfg.MirrorURL != "" {
+		if _, err := parseMirrorOptions(cfg.MirrorURL, cfg.MirrorPercent); err != nil {
+			return err
+		}
+	}
+	if err := validateUnknownFieldsMode(cfg.UnknownFields); err != nil {
+		return err
+	}
+	_, err := NewRoleBindings(cfg, tlsConfig)
+	return err
 }
 
 func (s *Server) Run(ctx context.Context) error {
-	router := chi.NewRouter()
-	router.Use(middleware.Logger)
-	router.Use(middleware.Recoverer)
+	tlsConfig, err := newTLSConfig(s.config, &s.certificate)
+	if err != nil {
+		return err
+	}
+	if err := ValidateConfig(s.config, tlsConfig); err != nil {
+		return err
+	}
 
-	swagger, err := v1alpha1.GetSwagger()
+	router := chi.NewRouter()
+	router.Use(RequestID)
+	if tlsConfig != nil && tlsConfig.ClientCAs != nil {
+		router.Use(ClientIdentity)
+	}
+	if s.config.AccessLogEnabled {
+		accessLog, err := AccessLog(AccessLogOptions{
+			Format:       s.config.AccessLogFormat,
+			Fields:       s.config.AccessLogFields,
+			ExcludePaths: s.config.AccessLogExcludePaths,
+			Output:       os.Stdout,
+		})
+		if err != nil {
+			return err
+		}
+		router.Use(accessLog)
+	}
+	router.Use(Recoverer)
+	if s.config.CompressionEnabled {
+		router.Use(Compress(s.config.CompressionMinSize))
+	}
+	if s.cors != nil {
+		router.Use(s.cors.middleware)
+	}
+	if s.rateLimiter != nil {
+		router.Use(s.rateLimiter.middleware)
+	}
+	if s.config.MaxRequestBodySize > 0 {
+		router.Use(MaxBodySize(s.config.MaxRequestBodySize))
+	}
+	if s.config.MirrorURL != "" {
+		mirror, err := Mirror(MirrorOptions{
+			Target:  s.config.MirrorURL,
+			Percent: s.config.MirrorPercent,
+			Timeout: s.config.MirrorTimeout,
+		})
+		if err != nil {
+			return err
+		}
+		router.Use(mirror)
+	}
+	var strictMiddlewares []server.StrictMiddlewareFunc
+	if s.config.ProcessingTimeHeader {
+		router.Use(ProcessingTime(s.config.Debug))
+		strictMiddlewares = append(strictMiddlewares, trackHandlerTime)
+	}
+	bindings, err := NewRoleBindings(s.config, tlsConfig)
 	if err != nil {
-		return fmt.Errorf("failed to load swagger spec: %w", err)
+		return err
+	}
+	if bindings != nil {
+		strictMiddlewares = append(strictMiddlewares, authorize(bindings))
 	}
 
-	baseURL := ""
-	if len(swagger.Servers) > 0 {
-		baseURL = swagger.Servers[0].URL
+	router.Get(livenessPath, livenessHandler)
+	router.Get(readinessPath, readinessHandler(s.readiness, &s.draining))
+
+	opts := GroupOptions{
+		StrictMiddlewares: strictMiddlewares,
+		APIDocsEnabled:    s.config.APIDocsEnabled,
+		UnknownFields:     s.config.UnknownFields,
+	}
+	for _, group := range s.routes.groups {
+		router.Group(func(r chi.Router) {
+			r.Use(group.Middlewares...)
+			err = group.Register(r, opts)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to register the %s routes: %w", group.Name, err)
+		}
 	}
 
-	// Mount the generated handler with base URL from OpenAPI spec
-	server.HandlerFromMuxWithBaseURL(
-		server.NewStrictHandler(s.handler, nil),
-		router,
-		baseURL,
-	)
+	if s.config.UIEnabled {
+		uiHandler := ui.Handler()
+		router.Handle(ui.Prefix, uiHandler)
+		router.Handle(ui.Prefix+"/*", uiHandler)
+	}
 
 	// Create HTTP server
-	srv := &http.Server{Handler: router}
+	drainTimeout := s.config.ShutdownDrainTimeout
+	if drainTimeout <= 0 {
+		drainTimeout = gracefulShutdownTimeout
+	}
+	return serve(s.drain(ctx), &http.Server{Handler: router, TLSConfig: tlsConfig}, s.listener, "API server", drainTimeout)
+}
+
+// drain returns a context cancelled once ctx is, after failing the readiness
+// probe for the configured delay so that load balancers stop routing new
+// traffic to this replica before it stops accepting connections.
+func (s *Server) drain(ctx context.Context) context.Context {
+	if s.config.ShutdownReadinessDelay <= 0 {
+		return ctx
+	}
+
+	drainCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+	go func() {
+		defer cancel()
+		<-ctx.Done()
+		s.draining.Store(true)
+		slog.Info("Failing readiness before shutting down", "delay", s.config.ShutdownReadinessDelay)
+		time.Sleep(s.config.ShutdownReadinessDelay)
+	}()
+	return drainCtx
+}
+
+// serve runs srv on listener, over TLS if srv.TLSConfig is set, until ctx is
+// cancelled, then stops accepting connections and waits up to drainTimeout
+// for in-flight requests to complete.
+func serve(ctx context.Context, srv *http.Server, listener net.Listener, name string, drainTimeout time.Duration) error {
+	logger := slog.With("server", name)
 
+	shutdownDone := make(chan struct{})
 	go func() {
+		defer close(shutdownDone)
 		<-ctx.Done()
-		ctxTimeout, cancel := context.WithTimeout(context.Background(), gracefulShutdownTimeout)
+		ctxTimeout, cancel := context.WithTimeout(context.Background(), drainTimeout)
 		defer cancel()
 		srv.SetKeepAlivesEnabled(false)
-		log.Println("Shutting down server...")
-		_ = srv.Shutdown(ctxTimeout)
+		logger.Info("Shutting down server")
+		if err := srv.Shutdown(ctxTimeout); err != nil {
+			logger.Warn("In-flight requests did not complete in time, closing their connections",
+				"timeout", drainTimeout)
+			_ = srv.Close()
+		}
 	}()
 
-	log.Printf("Starting server on %s", s.listener.Addr())
-	if err := srv.Serve(s.listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	logger.Info("Starting server", "address", listener.Addr().String(), "tls", srv.TLSConfig != nil)
+	var err error
+	if srv.TLSConfig != nil {
+		// The certificate is provided by the TLS config
+		err = srv.ServeTLS(listener, "", "")
+	} else {
+		err = srv.Serve(listener)
+	}
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return err
 	}
+	// Serve returns as soon as shutdown starts; wait for the drain
+	<-shutdownDone
 
-	log.Println("Server stopped")
+	logger.Info("Server stopped")
 	return nil
 }
@@ -0,0 +1,205 @@
+package apiserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	handlers "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+// startServer runs an API server on a loopback listener and returns its base URL.
+func startServer(cfg *config.Config, checks ...health.Check) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).ToNot(HaveOccurred())
+
+	readiness := health.NewChecker(cfg.ReadinessTimeout)
+	for i, check := range checks {
+		readiness.Register(fmt.Sprintf("check-%d", i), check)
+	}
+	routes := apiserver.NewRoutingTable(apiserver.V1Alpha1(handlers.NewHandler(readiness)))
+	return runServer(apiserver.New(cfg, listener, routes, readiness), listener)
+}
+
+// runServer runs srv until the end of the spec and returns its base URL.
+func runServer(srv *apiserver.Server, listener net.Listener) string {
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- srv.Run(ctx)
+	}()
+	DeferCleanup(func() {
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	return "http://" + listener.Addr().String()
+}
+
+// httpsURL turns a base URL returned by startServer into its https form.
+func httpsURL(baseURL string) string {
+	return "https://" + strings.TrimPrefix(baseURL, "http://")
+}
+
+var _ = Describe("Server", func() {
+	var cfg *config.Config
+
+	BeforeEach(func() {
+		cfg = &config.Config{ReadinessTimeout: time.Second}
+	})
+
+	It("should serve the API", func() {
+		baseURL := startServer(cfg)
+
+		resp, err := http.Get(baseURL + "/api/v1alpha1/health")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should serve the OpenAPI document", func() {
+		baseURL := startServer(cfg)
+
+		resp, err := http.Get(baseURL + "/api/v1alpha1/openapi.json")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/json"))
+
+		var doc map[string]any
+		Expect(json.NewDecoder(resp.Body).Decode(&doc)).To(Succeed())
+		Expect(doc).To(HaveKey("paths"))
+		Expect(doc["openapi"]).To(HavePrefix("3."))
+	})
+
+	It("should only serve the docs page when enabled", func() {
+		baseURL := startServer(cfg)
+		resp, err := http.Get(baseURL + "/api/v1alpha1/docs")
+		Expect(err).ToNot(HaveOccurred())
+		resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+
+		cfg.APIDocsEnabled = true
+		baseURL = startServer(cfg)
+		resp, err = http.Get(baseURL + "/api/v1alpha1/docs")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		body, err := io.ReadAll(resp.Body)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring(`spec-url="/api/v1alpha1/openapi.json"`))
+	})
+
+	It("should answer the liveness probe", func() {
+		baseURL := startServer(cfg, func(ctx context.Context) error { return errors.New("database down") })
+
+		resp, err := http.Get(baseURL + "/healthz")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should report ready when all checks pass", func() {
+		baseURL := startServer(cfg, func(ctx context.Context) error { return nil })
+
+		resp, err := http.Get(baseURL + "/readyz")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+	})
+
+	It("should report unavailable when a check fails", func() {
+		baseURL := startServer(cfg,
+			func(ctx context.Context) error { return nil },
+			func(ctx context.Context) error { return errors.New("database down") },
+		)
+
+		resp, err := http.Get(baseURL + "/readyz")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusServiceUnavailable))
+
+		var body struct {
+			Status string            `json:"status"`
+			Checks map[string]string `json:"checks"`
+		}
+		Expect(json.NewDecoder(resp.Body).Decode(&body)).To(Succeed())
+		Expect(body.Status).To(Equal("unavailable"))
+		Expect(body.Checks).To(Equal(map[string]string{"check-0": "ok", "check-1": "database down"}))
+	})
+
+	It("should serve the JSON Schema of resource kinds", func() {
+		baseURL := startServer(cfg)
+
+		resp, err := http.Get(baseURL + "/api/v1alpha1/schemas/CatalogItem")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.Header.Get("Content-Type")).To(Equal("application/schema+json"))
+
+		var schema map[string]any
+		Expect(json.NewDecoder(resp.Body).Decode(&schema)).To(Succeed())
+		Expect(schema).To(HaveKeyWithValue("$schema", "https://json-schema.org/draft/2020-12/schema"))
+		Expect(schema).To(HaveKeyWithValue("title", "CatalogItem"))
+		Expect(schema).ToNot(HaveKey("x-aep-resource"))
+		Expect(schema["properties"]).To(HaveKeyWithValue("spec", HaveKeyWithValue("$ref", "#/$defs/CatalogItemSpec")))
+		Expect(schema["$defs"]).To(HaveKey("CatalogItemSpec"))
+		Expect(schema["$defs"]).To(HaveKey("FieldConfiguration"))
+
+		fieldConfiguration := schema["$defs"].(map[string]any)["FieldConfiguration"].(map[string]any)
+		defaultValue := fieldConfiguration["properties"].(map[string]any)["default"].(map[string]any)
+		Expect(defaultValue).ToNot(HaveKey("nullable"))
+		Expect(defaultValue).To(HaveKeyWithValue("examples", ConsistOf(BeNumerically("==", 2))))
+	})
+
+	It("should answer 404 for unknown kinds", func() {
+		baseURL := startServer(cfg)
+
+		resp, err := http.Get(baseURL + "/api/v1alpha1/schemas/Unknown")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+	})
+})
+
+var _ = Describe("ValidateConfig", func() {
+	It("should accept a valid configuration", func() {
+		Expect(apiserver.ValidateConfig(&config.Config{
+			AccessLogEnabled: true,
+			AccessLogFormat:  apiserver.AccessLogFormatJSON,
+			AccessLogFields:  []string{apiserver.AccessLogFieldLatency},
+			MirrorURL:        "http://canary:8080",
+			MirrorPercent:    10,
+			UnknownFields:    apiserver.UnknownFieldsLenient,
+		}, nil)).To(Succeed())
+	})
+
+	DescribeTable("should reject the settings that the server cannot start with",
+		func(cfg *config.Config, message string) {
+			Expect(apiserver.ValidateConfig(cfg, nil)).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("access log format", &config.Config{
+			AccessLogEnabled: true, AccessLogFormat: "xml",
+		}, "invalid access log format"),
+		Entry("access log field", &config.Config{
+			AccessLogEnabled: true, AccessLogFormat: apiserver.AccessLogFormatJSON, AccessLogFields: []string{"referer"},
+		}, "invalid access log field"),
+		Entry("mirror URL", &config.Config{MirrorURL: "canary:8080"}, "invalid mirror URL"),
+		Entry("mirror percent", &config.Config{MirrorURL: "http://canary:8080", MirrorPercent: 150}, "invalid mirror percent"),
+		Entry("unknown fields mode", &config.Config{UnknownFields: "ignore"}, "invalid unknown fields mode"),
+		Entry("authorization without client certificates", &config.Config{AuthorizationEnabled: true}, "TLS_CLIENT_CA_FILE"),
+	)
+})
@@ -0,0 +1,123 @@
+package apiserver_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+	"github.com/dcm-project/catalog-manager/internal/health"
+	"github.com/go-chi/chi/v5"
+)
+
+var _ = Describe("Shutdown", func() {
+	type runningServer struct {
+		baseURL string
+		cancel  context.CancelFunc
+		done    chan error
+		// started is closed when /slow is called, which then blocks until
+		// release is closed
+		started chan struct{}
+		release chan struct{}
+	}
+
+	run := func(cfg *config.Config) *runningServer {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+
+		started, release := make(chan struct{}), make(chan struct{})
+		routes := apiserver.NewRoutingTable(apiserver.Group{
+			Name: "slow",
+			Register: func(router chi.Router, opts apiserver.GroupOptions) error {
+				router.Get("/slow", func(w http.ResponseWriter, r *http.Request) {
+					close(started)
+					<-release
+				})
+				return nil
+			},
+		})
+		readiness := health.NewChecker(time.Second)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan error, 1)
+		go func() {
+			done <- apiserver.New(cfg, listener, routes, readiness).Run(ctx)
+		}()
+		DeferCleanup(cancel)
+
+		return &runningServer{
+			baseURL: "http://" + listener.Addr().String(),
+			cancel:  cancel,
+			done:    done,
+			started: started,
+			release: release,
+		}
+	}
+
+	It("should wait for in-flight requests to complete", func() {
+		srv := run(&config.Config{ShutdownDrainTimeout: 5 * time.Second})
+
+		status := make(chan int, 1)
+		go func() {
+			defer GinkgoRecover()
+			resp, err := http.Get(srv.baseURL + "/slow")
+			Expect(err).ToNot(HaveOccurred())
+			resp.Body.Close()
+			status <- resp.StatusCode
+		}()
+		Eventually(srv.started).Should(BeClosed())
+
+		srv.cancel()
+		Consistently(srv.done, 200*time.Millisecond).ShouldNot(Receive())
+
+		close(srv.release)
+		Eventually(status).Should(Receive(Equal(http.StatusOK)))
+		Eventually(srv.done).Should(Receive(BeNil()))
+	})
+
+	It("should close the connections of requests outliving the drain timeout", func() {
+		srv := run(&config.Config{ShutdownDrainTimeout: 100 * time.Millisecond})
+		DeferCleanup(func() { close(srv.release) })
+
+		failed := make(chan error, 1)
+		go func() {
+			resp, err := http.Get(srv.baseURL + "/slow")
+			if err == nil {
+				resp.Body.Close()
+			}
+			failed <- err
+		}()
+		Eventually(srv.started).Should(BeClosed())
+
+		srv.cancel()
+		Eventually(srv.done).Should(Receive(BeNil()))
+		Eventually(failed).Should(Receive(HaveOccurred()))
+	})
+
+	It("should fail readiness before shutting down", func() {
+		srv := run(&config.Config{
+			ShutdownReadinessDelay: 500 * time.Millisecond,
+			ShutdownDrainTimeout:   time.Second,
+		})
+		close(srv.release)
+
+		readyz := func() int {
+			resp, err := http.Get(srv.baseURL + "/readyz")
+			if err != nil {
+				return 0
+			}
+			resp.Body.Close()
+			return resp.StatusCode
+		}
+		Expect(readyz()).To(Equal(http.StatusOK))
+
+		srv.cancel()
+		Eventually(readyz).Should(Equal(http.StatusServiceUnavailable))
+		Eventually(srv.done).WithTimeout(5 * time.Second).Should(Receive(BeNil()))
+	})
+})
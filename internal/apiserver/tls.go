@@ -0,0 +1,130 @@
+package apiserver
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+// NewTLSConfig builds the TLS configuration of the API server from cfg. It
+// returns nil when TLS is not configured, and an error when the certificate
+// or key is missing, unreadable, mismatched or expired, or when the client
+// CA bundle is invalid.
+func NewTLSConfig(cfg *config.Config) (*tls.Config, error) {
+	return newTLSConfig(cfg, new(atomic.Pointer[tls.Certificate]))
+}
+
+// newTLSConfig is NewTLSConfig serving the certificate held by certificate,
+// so that it can be replaced while serving.
+func newTLSConfig(cfg *config.Config, certificate *atomic.Pointer[tls.Certificate]) (*tls.Config, error) {
+	cert, err := loadCertificate(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cert == nil {
+		if cfg.TLSClientCAFile != "" {
+			return nil, errors.New("TLS_CLIENT_CA_FILE requires TLS to be enabled")
+		}
+		return nil, nil
+	}
+	certificate.Store(cert)
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return certificate.Load(), nil
+		},
+	}
+	if cfg.TLSClientCAFile != "" {
+		if err := configureClientAuth(tlsConfig, cfg); err != nil {
+			return nil, err
+		}
+	}
+	return tlsConfig, nil
+}
+
+// loadCertificate loads and validates the certificate configured in cfg. It
+// returns nil when TLS is not configured.
+func loadCertificate(cfg *config.Config) (*tls.Certificate, error) {
+	certPEM, keyPEM, err := tlsKeyPair(cfg)
+	if err != nil || certPEM == nil {
+		return nil, err
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS certificate or key: %w", err)
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid TLS certificate: %w", err)
+	}
+	if now := time.Now(); now.After(leaf.NotAfter) || now.Before(leaf.NotBefore) {
+		return nil, fmt.Errorf("TLS certificate is only valid from %s to %s",
+			leaf.NotBefore.Format(time.RFC3339), leaf.NotAfter.Format(time.RFC3339))
+	}
+	cert.Leaf = leaf
+	return &cert, nil
+}
+
+// configureClientAuth makes tlsConfig verify client certificates against the
+// CA bundle in cfg.TLSClientCAFile.
+func configureClientAuth(tlsConfig *tls.Config, cfg *config.Config) error {
+	switch cfg.TLSClientAuth {
+	case "require":
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	case "optional":
+		tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	default:
+		return fmt.Errorf("invalid TLS client auth %q: must be require or optional", cfg.TLSClientAuth)
+	}
+
+	bundle, err := os.ReadFile(cfg.TLSClientCAFile)
+	if err != nil {
+		return fmt.Errorf("failed to read TLS client CA bundle: %w", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bundle) {
+		return fmt.Errorf("no certificates found in TLS client CA bundle %s", cfg.TLSClientCAFile)
+	}
+	tlsConfig.ClientCAs = pool
+	return nil
+}
+
+// tlsKeyPair returns the PEM encoded certificate and key, read either from
+// files or from the inline values. Both are nil when TLS is not configured.
+func tlsKeyPair(cfg *config.Config) ([]byte, []byte, error) {
+	fromFiles := cfg.TLSCertFile != "" || cfg.TLSKeyFile != ""
+	inline := cfg.TLSCert != "" || cfg.TLSKey != ""
+
+	switch {
+	case fromFiles && inline:
+		return nil, nil, errors.New("TLS_CERT_FILE/TLS_KEY_FILE and TLS_CERT/TLS_KEY are mutually exclusive")
+	case fromFiles:
+		if cfg.TLSCertFile == "" || cfg.TLSKeyFile == "" {
+			return nil, nil, errors.New("both TLS_CERT_FILE and TLS_KEY_FILE must be set")
+		}
+		certPEM, err := os.ReadFile(cfg.TLSCertFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read TLS certificate: %w", err)
+		}
+		keyPEM, err := os.ReadFile(cfg.TLSKeyFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to read TLS key: %w", err)
+		}
+		return certPEM, keyPEM, nil
+	case inline:
+		if cfg.TLSCert == "" || cfg.TLSKey == "" {
+			return nil, nil, errors.New("both TLS_CERT and TLS_KEY must be set")
+		}
+		return []byte(cfg.TLSCert), []byte(cfg.TLSKey), nil
+	default:
+		return nil, nil, nil
+	}
+}
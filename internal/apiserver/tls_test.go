@@ -0,0 +1,110 @@
+package apiserver_test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+var _ = Describe("TLS", func() {
+	var cert *testCert
+
+	BeforeEach(func() {
+		cert = validTestCert("localhost")
+	})
+
+	writeFile := func(name string, content []byte) string {
+		path := filepath.Join(GinkgoT().TempDir(), name)
+		Expect(os.WriteFile(path, content, 0o600)).To(Succeed())
+		return path
+	}
+
+	Describe("NewTLSConfig", func() {
+		It("should return nil when TLS is not configured", func() {
+			tlsConfig, err := apiserver.NewTLSConfig(&config.Config{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tlsConfig).To(BeNil())
+		})
+
+		It("should load the certificate from files", func() {
+			tlsConfig, err := apiserver.NewTLSConfig(&config.Config{
+				TLSCertFile: writeFile("tls.crt", cert.CertPEM),
+				TLSKeyFile:  writeFile("tls.key", cert.KeyPEM),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tlsConfig).ToNot(BeNil())
+		})
+
+		It("should load an inline certificate", func() {
+			tlsConfig, err := apiserver.NewTLSConfig(&config.Config{
+				TLSCert: string(cert.CertPEM),
+				TLSKey:  string(cert.KeyPEM),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tlsConfig).ToNot(BeNil())
+		})
+
+		DescribeTable("should reject invalid configurations",
+			func(build func() *config.Config, message string) {
+				_, err := apiserver.NewTLSConfig(build())
+				Expect(err).To(MatchError(ContainSubstring(message)))
+			},
+			Entry("certificate without key", func() *config.Config {
+				return &config.Config{TLSCert: string(cert.CertPEM)}
+			}, "both TLS_CERT and TLS_KEY"),
+			Entry("files and inline", func() *config.Config {
+				return &config.Config{TLSCertFile: "tls.crt", TLSKey: string(cert.KeyPEM)}
+			}, "mutually exclusive"),
+			Entry("missing file", func() *config.Config {
+				return &config.Config{TLSCertFile: "/nonexistent/tls.crt", TLSKeyFile: "/nonexistent/tls.key"}
+			}, "failed to read TLS certificate"),
+			Entry("mismatched key", func() *config.Config {
+				return &config.Config{TLSCert: string(cert.CertPEM), TLSKey: string(validTestCert("other").KeyPEM)}
+			}, "invalid TLS certificate or key"),
+			Entry("expired certificate", func() *config.Config {
+				expired := newTestCert("localhost", nil, true, time.Now().Add(-2*time.Hour), time.Now().Add(-time.Hour))
+				return &config.Config{TLSCert: string(expired.CertPEM), TLSKey: string(expired.KeyPEM)}
+			}, "only valid from"),
+			Entry("client CA without TLS", func() *config.Config {
+				return &config.Config{TLSClientCAFile: "ca.crt"}
+			}, "requires TLS to be enabled"),
+			Entry("invalid client auth mode", func() *config.Config {
+				return &config.Config{
+					TLSCert:         string(cert.CertPEM),
+					TLSKey:          string(cert.KeyPEM),
+					TLSClientCAFile: writeFile("ca.crt", cert.CertPEM),
+					TLSClientAuth:   "sometimes",
+				}
+			}, "must be require or optional"),
+			Entry("empty client CA bundle", func() *config.Config {
+				return &config.Config{
+					TLSCert:         string(cert.CertPEM),
+					TLSKey:          string(cert.KeyPEM),
+					TLSClientCAFile: writeFile("ca.crt", []byte("not a certificate")),
+					TLSClientAuth:   "require",
+				}
+			}, "no certificates found"),
+		)
+	})
+
+	It("should serve the API over TLS", func() {
+		baseURL := startServer(&config.Config{
+			ReadinessTimeout: time.Second,
+			TLSCert:          string(cert.CertPEM),
+			TLSKey:           string(cert.KeyPEM),
+		})
+
+		resp, err := httpsClient(cert).Get(httpsURL(baseURL) + "/api/v1alpha1/health")
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+		Expect(resp.StatusCode).To(Equal(http.StatusOK))
+		Expect(resp.TLS).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,169 @@
+package apiserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+)
+
+const (
+	// UnknownFieldsStrict rejects request bodies with unknown fields
+	UnknownFieldsStrict = "strict"
+	// UnknownFieldsLenient ignores unknown fields, with a Warning header
+	UnknownFieldsLenient = "lenient"
+
+	// UnknownFieldsHeader lets clients override the mode for one request
+	UnknownFieldsHeader = "X-Unknown-Fields"
+)
+
+// UnknownFields returns a middleware looking for fields that the schema of
+// the operation does not define in JSON request bodies. In strict mode they
+// are rejected with 400 listing the unknown fields; in lenient mode they
+// are ignored, with a Warning header per field. The mode may be overridden
+// per request with the X-Unknown-Fields header. The mode defaults to
+// strict.
+//
+// The operation is identified by the route pattern, so the middleware must
+// be used on the routes of swagger mounted at baseURL.
+func UnknownFields(swagger *openapi3.T, baseURL, mode string) (func(http.Handler) http.Handler, error) {
+	if mode == "" {
+		mode = UnknownFieldsStrict
+	}
+	if err := validateUnknownFieldsMode(mode); err != nil {
+		return nil, err
+	}
+
+	// Request body schemas by method, route pattern and media type
+	schemas := map[string]map[string]*openapi3.Schema{}
+	for path, item := range swagger.Paths.Map() {
+		for method, operation := range item.Operations() {
+			if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+				continue
+			}
+			byType := map[string]*openapi3.Schema{}
+			for mediaType, content := range operation.RequestBody.Value.Content {
+				if content.Schema != nil && content.Schema.Value != nil {
+					byType[mediaType] = content.Schema.Value
+				}
+			}
+			schemas[method+" "+baseURL+path] = byType
+		}
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			byType, ok := schemas[r.Method+" "+chi.RouteContext(r.Context()).RoutePattern()]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+			mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			schema, ok := byType[mediaType]
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			requestMode := mode
+			if override := r.Header.Get(UnknownFieldsHeader); override != "" {
+				if override != UnknownFieldsStrict && override != UnknownFieldsLenient {
+					writeProblem(w, http.StatusBadRequest, v1alpha1.INVALIDARGUMENT, "Invalid header",
+						fmt.Sprintf("%s must be %s or %s", UnknownFieldsHeader, UnknownFieldsStrict, UnknownFieldsLenient),
+						middleware.GetReqID(r.Context()))
+					return
+				}
+				requestMode = override
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				// Let the handler report the failure
+				next.ServeHTTP(w, r)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			var document any
+			if err := json.Unmarshal(body, &document); err != nil {
+				// Malformed bodies are reported by the handler
+				next.ServeHTTP(w, r)
+				return
+			}
+			unknown := unknownFields(schema, document, "")
+			if len(unknown) > 0 {
+				if requestMode == UnknownFieldsStrict {
+					writeProblem(w, http.StatusBadRequest, v1alpha1.INVALIDARGUMENT, "Unknown fields",
+						"Unknown fields in request body: "+strings.Join(unknown, ", "),
+						middleware.GetReqID(r.Context()))
+					return
+				}
+				for _, field := range unknown {
+					w.Header().Add("Warning", fmt.Sprintf("299 - %q", "unknown field "+field+" ignored"))
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// validateUnknownFieldsMode checks mode, where empty stands for strict.
+func validateUnknownFieldsMode(mode string) error {
+	if mode != "" && mode != UnknownFieldsStrict && mode != UnknownFieldsLenient {
+		return fmt.Errorf("invalid unknown fields mode %q: must be %s or %s",
+			mode, UnknownFieldsStrict, UnknownFieldsLenient)
+	}
+	return nil
+}
+
+// unknownFields returns the paths of the fields of value that schema does
+// not define, in order. Objects only accept undefined fields when their
+// schema explicitly allows additional properties.
+func unknownFields(schema *openapi3.Schema, value any, path string) []string {
+	var unknown []string
+	switch v := value.(type) {
+	case map[string]any:
+		if schema.Properties == nil && (schema.Type == nil || !schema.Type.Is(openapi3.TypeObject)) {
+			return nil
+		}
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+		for _, key := range keys {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			property, ok := schema.Properties[key]
+			switch {
+			case ok && property.Value != nil:
+				unknown = append(unknown, unknownFields(property.Value, v[key], fieldPath)...)
+			case schema.AdditionalProperties.Schema != nil && schema.AdditionalProperties.Schema.Value != nil:
+				unknown = append(unknown, unknownFields(schema.AdditionalProperties.Schema.Value, v[key], fieldPath)...)
+			case schema.AdditionalProperties.Has != nil && *schema.AdditionalProperties.Has:
+			default:
+				unknown = append(unknown, fieldPath)
+			}
+		}
+	case []any:
+		if schema.Items == nil || schema.Items.Value == nil {
+			return nil
+		}
+		for i, item := range v {
+			unknown = append(unknown, unknownFields(schema.Items.Value, item, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	}
+	return unknown
+}
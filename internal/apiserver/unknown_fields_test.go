@@ -0,0 +1,110 @@
+package apiserver_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/apiserver"
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+var _ = Describe("UnknownFields", func() {
+	const catalogItem = `{
+		"api_version": "v1alpha1",
+		"display_name": "Small VM",
+		"colour": "blue",
+		"spec": {
+			"service_type": "vm",
+			"fields": [{"path": "spec.vcpu.count", "default": {"any": "value"}, "bogus": true}]
+		}
+	}`
+
+	post := func(baseURL, body string, headers ...string) (*http.Response, v1alpha1.Error) {
+		req, err := http.NewRequest(http.MethodPost, baseURL+"/api/v1alpha1/catalog-items", strings.NewReader(body))
+		Expect(err).ToNot(HaveOccurred())
+		req.Header.Set("Content-Type", "application/json")
+		for i := 0; i+1 < len(headers); i += 2 {
+			req.Header.Set(headers[i], headers[i+1])
+		}
+		resp, err := http.DefaultClient.Do(req)
+		Expect(err).ToNot(HaveOccurred())
+		defer resp.Body.Close()
+
+		var problem v1alpha1.Error
+		Expect(json.NewDecoder(resp.Body).Decode(&problem)).To(Succeed())
+		return resp, problem
+	}
+
+	Context("in strict mode", func() {
+		var baseURL string
+
+		BeforeEach(func() {
+			baseURL = startServer(&config.Config{
+				ReadinessTimeout: time.Second,
+				UnknownFields:    apiserver.UnknownFieldsStrict,
+			})
+		})
+
+		It("should reject unknown fields, listing them", func() {
+			resp, problem := post(baseURL, catalogItem)
+
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			Expect(problem.Type).To(Equal(v1alpha1.INVALIDARGUMENT))
+			Expect(*problem.Detail).To(Equal("Unknown fields in request body: colour, spec.fields[0].bogus"))
+		})
+
+		It("should accept known fields", func() {
+			resp, problem := post(baseURL, `{"api_version": "v1alpha1", "display_name": "Small VM"}`)
+
+			// The stub answers once the body is accepted
+			Expect(resp.StatusCode).ToNot(Equal(http.StatusBadRequest))
+			Expect(problem.Type).To(Equal(v1alpha1.UNIMPLEMENTED))
+		})
+
+		It("should let clients ask for lenient handling", func() {
+			resp, _ := post(baseURL, catalogItem, apiserver.UnknownFieldsHeader, apiserver.UnknownFieldsLenient)
+
+			Expect(resp.StatusCode).ToNot(Equal(http.StatusBadRequest))
+		})
+
+		It("should reject invalid modes", func() {
+			resp, problem := post(baseURL, catalogItem, apiserver.UnknownFieldsHeader, "sloppy")
+
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+			Expect(*problem.Detail).To(ContainSubstring(apiserver.UnknownFieldsHeader))
+		})
+	})
+
+	Context("in lenient mode", func() {
+		var baseURL string
+
+		BeforeEach(func() {
+			baseURL = startServer(&config.Config{
+				ReadinessTimeout: time.Second,
+				UnknownFields:    apiserver.UnknownFieldsLenient,
+			})
+		})
+
+		It("should ignore unknown fields with warnings", func() {
+			resp, problem := post(baseURL, catalogItem)
+
+			Expect(problem.Type).To(Equal(v1alpha1.UNIMPLEMENTED))
+			Expect(resp.Header.Values("Warning")).To(ConsistOf(
+				`299 - "unknown field colour ignored"`,
+				`299 - "unknown field spec.fields[0].bogus ignored"`,
+			))
+		})
+
+		It("should let clients ask for strict handling", func() {
+			resp, _ := post(baseURL, catalogItem, apiserver.UnknownFieldsHeader, apiserver.UnknownFieldsStrict)
+
+			Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,58 @@
+package apiserver
+
+import (
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/go-chi/chi/v5"
+)
+
+const v1alpha1Version = "v1alpha1"
+
+// V1Alpha1 is the group serving the v1alpha1 API implemented by handler,
+// along with its OpenAPI spec, JSON Schemas and, if enabled, documentation.
+func V1Alpha1(handler server.StrictServerInterface) Group {
+	return Group{
+		Name: v1alpha1Version,
+		Register: func(router chi.Router, opts GroupOptions) error {
+			swagger, err := v1alpha1.GetSwagger()
+			if err != nil {
+				return fmt.Errorf("failed to load swagger spec: %w", err)
+			}
+
+			baseURL := ""
+			if len(swagger.Servers) > 0 {
+				baseURL = swagger.Servers[0].URL
+			}
+
+			unknownFields, err := UnknownFields(swagger, baseURL, opts.UnknownFields)
+			if err != nil {
+				return err
+			}
+			router.Use(unknownFields, ConditionalGet(swagger, baseURL), PartialResponse(swagger, baseURL), APIVersion(v1alpha1Version))
+
+			// Mount the generated handler with base URL from OpenAPI spec
+			server.HandlerFromMuxWithBaseURL(
+				server.NewStrictHandler(handler, opts.StrictMiddlewares),
+				router,
+				baseURL,
+			)
+
+			openAPI, err := openAPIHandler(swagger)
+			if err != nil {
+				return err
+			}
+			router.Get(baseURL+"/openapi.json", openAPI)
+			jsonSchemas, err := jsonSchemasHandler(swagger)
+			if err != nil {
+				return err
+			}
+			router.Get(baseURL+"/schemas/{kind}", jsonSchemas)
+			if opts.APIDocsEnabled {
+				router.Get(baseURL+"/docs", docsHandler(swagger, baseURL+"/openapi.json"))
+			}
+			return nil
+		},
+	}
+}
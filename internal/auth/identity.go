@@ -0,0 +1,42 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+)
+
+type contextKey struct{}
+
+// ClientIdentity identifies a client authenticated by its TLS certificate.
+type ClientIdentity struct {
+	CommonName     string
+	DNSNames       []string
+	EmailAddresses []string
+	URIs           []string
+}
+
+// IdentityFromCertificate returns the identity carried by the subject and
+// subject alternative names of cert.
+func IdentityFromCertificate(cert *x509.Certificate) ClientIdentity {
+	identity := ClientIdentity{
+		CommonName:     cert.Subject.CommonName,
+		DNSNames:       cert.DNSNames,
+		EmailAddresses: cert.EmailAddresses,
+	}
+	for _, uri := range cert.URIs {
+		identity.URIs = append(identity.URIs, uri.String())
+	}
+	return identity
+}
+
+// WithClientIdentity returns a context carrying identity.
+func WithClientIdentity(ctx context.Context, identity ClientIdentity) context.Context {
+	return context.WithValue(ctx, contextKey{}, identity)
+}
+
+// ClientIdentityFromContext returns the identity carried by ctx, if the
+// client was authenticated.
+func ClientIdentityFromContext(ctx context.Context) (ClientIdentity, bool) {
+	identity, ok := ctx.Value(contextKey{}).(ClientIdentity)
+	return identity, ok
+}
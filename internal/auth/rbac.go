@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Role grants access to a set of operations. Each role grants everything
+// the previous ones do.
+type Role string
+
+const (
+	// RoleViewer may only read resources
+	RoleViewer Role = "viewer"
+	// RoleEditor may also manage catalog items and their instances
+	RoleEditor Role = "editor"
+	// RoleAdmin may also manage service types
+	RoleAdmin Role = "admin"
+)
+
+// roles are ordered by increasing privilege
+var roles = []Role{RoleViewer, RoleEditor, RoleAdmin}
+
+func ParseRole(s string) (Role, error) {
+	role := Role(s)
+	if !slices.Contains(roles, role) {
+		return "", fmt.Errorf("invalid role %q: must be one of %v", s, roles)
+	}
+	return role, nil
+}
+
+// Grants reports whether r grants everything required grants.
+func (r Role) Grants(required Role) bool {
+	return slices.Index(roles, r) >= slices.Index(roles, required)
+}
+
+// RoleBindings maps client identities to roles.
+type RoleBindings struct {
+	bindings    map[string]Role
+	defaultRole Role
+}
+
+// NewRoleBindings binds each client common name in bindings to a role.
+// Clients without a binding are given defaultRole, or no role at all when
+// it is empty.
+func NewRoleBindings(bindings map[string]string, defaultRole string) (*RoleBindings, error) {
+	b := &RoleBindings{bindings: map[string]Role{}}
+	for name, value := range bindings {
+		role, err := ParseRole(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid role binding for %q: %w", name, err)
+		}
+		b.bindings[name] = role
+	}
+	if defaultRole != "" {
+		role, err := ParseRole(defaultRole)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default role: %w", err)
+		}
+		b.defaultRole = role
+	}
+	return b, nil
+}
+
+// RoleOf returns the role bound to identity.
+func (b *RoleBindings) RoleOf(identity ClientIdentity) (Role, bool) {
+	if role, ok := b.bindings[identity.CommonName]; ok {
+		return role, true
+	}
+	return b.defaultRole, b.defaultRole != ""
+}
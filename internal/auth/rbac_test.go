@@ -0,0 +1,54 @@
+package auth_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/auth"
+)
+
+var _ = Describe("Roles", func() {
+	It("should grant the privileges of less privileged roles", func() {
+		Expect(auth.RoleAdmin.Grants(auth.RoleEditor)).To(BeTrue())
+		Expect(auth.RoleEditor.Grants(auth.RoleViewer)).To(BeTrue())
+		Expect(auth.RoleEditor.Grants(auth.RoleEditor)).To(BeTrue())
+		Expect(auth.RoleViewer.Grants(auth.RoleEditor)).To(BeFalse())
+		Expect(auth.RoleEditor.Grants(auth.RoleAdmin)).To(BeFalse())
+	})
+
+	It("should reject unknown roles", func() {
+		_, err := auth.ParseRole("owner")
+		Expect(err).To(MatchError(ContainSubstring("invalid role")))
+	})
+})
+
+var _ = Describe("RoleBindings", func() {
+	It("should return the role bound to a client", func() {
+		bindings, err := auth.NewRoleBindings(map[string]string{"ci": "editor"}, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		role, ok := bindings.RoleOf(auth.ClientIdentity{CommonName: "ci"})
+		Expect(ok).To(BeTrue())
+		Expect(role).To(Equal(auth.RoleEditor))
+
+		_, ok = bindings.RoleOf(auth.ClientIdentity{CommonName: "stranger"})
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should fall back to the default role", func() {
+		bindings, err := auth.NewRoleBindings(nil, "viewer")
+		Expect(err).ToNot(HaveOccurred())
+
+		role, ok := bindings.RoleOf(auth.ClientIdentity{})
+		Expect(ok).To(BeTrue())
+		Expect(role).To(Equal(auth.RoleViewer))
+	})
+
+	It("should reject invalid bindings", func() {
+		_, err := auth.NewRoleBindings(map[string]string{"ci": "owner"}, "")
+		Expect(err).To(MatchError(ContainSubstring(`invalid role binding for "ci"`)))
+
+		_, err = auth.NewRoleBindings(nil, "owner")
+		Expect(err).To(MatchError(ContainSubstring("invalid default role")))
+	})
+})
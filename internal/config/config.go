@@ -1,15 +1,100 @@
 package config
 
-import "github.com/kelseyhightower/envconfig"
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
 
 type Config struct {
-	BindAddress string `envconfig:"BIND_ADDRESS" default:"0.0.0.0:8080"`
+	BindAddress          string `envconfig:"BIND_ADDRESS" default:"0.0.0.0:8080"`
+	UIEnabled            bool   `envconfig:"UI_ENABLED" default:"false"`
+	APIDocsEnabled       bool   `envconfig:"API_DOCS_ENABLED" default:"false"`
+	ProcessingTimeHeader bool   `envconfig:"PROCESSING_TIME_HEADER" default:"true"`
+	Debug                bool   `envconfig:"DEBUG" default:"false"`
+	LogLevel             string `envconfig:"LOG_LEVEL" default:"info"`
+	LogFormat            string `envconfig:"LOG_FORMAT" default:"text"`
+	// AdminBindAddress enables the pprof/expvar debug listener when set
+	AdminBindAddress string `envconfig:"ADMIN_BIND_ADDRESS" default:""`
+	// UnknownFields is "strict" to reject unknown fields in request bodies,
+	// or "lenient" to ignore them with a warning
+	UnknownFields string `envconfig:"UNKNOWN_FIELDS" default:"strict"`
+	// MaxRequestBodySize is in bytes; 0 disables the limit
+	MaxRequestBodySize int64 `envconfig:"MAX_REQUEST_BODY_SIZE" default:"1048576"`
+	// Responses of at least CompressionMinSize bytes are compressed with
+	// gzip for clients that accept it
+	CompressionEnabled bool `envconfig:"COMPRESSION_ENABLED" default:"true"`
+	CompressionMinSize int  `envconfig:"COMPRESSION_MIN_SIZE" default:"1024"`
+
+	AccessLogEnabled      bool     `envconfig:"ACCESS_LOG_ENABLED" default:"true"`
+	AccessLogFormat       string   `envconfig:"ACCESS_LOG_FORMAT" default:"json"`
+	AccessLogFields       []string `envconfig:"ACCESS_LOG_FIELDS" default:"latency,status,bytes,client_ip"`
+	AccessLogExcludePaths []string `envconfig:"ACCESS_LOG_EXCLUDE_PATHS" default:"/api/v1alpha1/health,/healthz,/readyz"`
+
+	ReadinessTimeout time.Duration `envconfig:"READINESS_TIMEOUT" default:"2s"`
+	// On shutdown the readiness probe fails for ShutdownReadinessDelay before
+	// the server stops accepting connections, then in-flight requests are
+	// given up to ShutdownDrainTimeout to complete
+	ShutdownReadinessDelay time.Duration `envconfig:"SHUTDOWN_READINESS_DELAY" default:"0s"`
+	ShutdownDrainTimeout   time.Duration `envconfig:"SHUTDOWN_DRAIN_TIMEOUT" default:"5s"`
+
+	// Rate limits apply per client, in requests per second
+	RateLimitEnabled    bool    `envconfig:"RATE_LIMIT_ENABLED" default:"false"`
+	RateLimitReadRate   float64 `envconfig:"RATE_LIMIT_READ_RATE" default:"50"`
+	RateLimitReadBurst  int     `envconfig:"RATE_LIMIT_READ_BURST" default:"100"`
+	RateLimitWriteRate  float64 `envconfig:"RATE_LIMIT_WRITE_RATE" default:"10"`
+	RateLimitWriteBurst int     `envconfig:"RATE_LIMIT_WRITE_BURST" default:"20"`
+
+	// MirrorURL enables mirroring MirrorPercent of read requests to a
+	// secondary instance, e.g. a canary, to compare their responses. It may
+	// embed credentials, so it can be read from MIRROR_URL_FILE instead.
+	// Requests authenticated with a client certificate are not mirrored,
+	// since the mirror cannot present it
+	MirrorURL     string        `envconfig:"MIRROR_URL" default:"" secret:"true"`
+	MirrorPercent float64       `envconfig:"MIRROR_PERCENT" default:"10"`
+	MirrorTimeout time.Duration `envconfig:"MIRROR_TIMEOUT" default:"5s"`
+
+	// TLS is enabled when a certificate and key are provided, either as
+	// file paths or as inline PEM
+	TLSCertFile string `envconfig:"TLS_CERT_FILE"`
+	TLSKeyFile  string `envconfig:"TLS_KEY_FILE"`
+	TLSCert     string `envconfig:"TLS_CERT"`
+	TLSKey      string `envconfig:"TLS_KEY"`
+	// Client certificates signed by the CA bundle in TLSClientCAFile are
+	// verified when set; TLSClientAuth is "require" or "optional"
+	TLSClientCAFile string `envconfig:"TLS_CLIENT_CA_FILE"`
+	TLSClientAuth   string `envconfig:"TLS_CLIENT_AUTH" default:"require"`
+
+	// AuthorizationEnabled restricts the API according to the role bound to
+	// the common name of the client certificate (e.g. "ci:editor,ops:admin"),
+	// falling back to DefaultRole for clients without a binding
+	AuthorizationEnabled bool              `envconfig:"AUTHORIZATION_ENABLED" default:"false"`
+	RoleBindings         map[string]string `envconfig:"ROLE_BINDINGS"`
+	DefaultRole          string            `envconfig:"DEFAULT_ROLE" default:""`
+
+	// CORS is enabled when allowed origins are set; "*" allows any origin
+	CORSAllowedOrigins []string      `envconfig:"CORS_ALLOWED_ORIGINS" default:""`
+	CORSAllowedMethods []string      `envconfig:"CORS_ALLOWED_METHODS" default:"GET,POST,PUT,PATCH,DELETE"`
+	CORSAllowedHeaders []string      `envconfig:"CORS_ALLOWED_HEADERS" default:"Content-Type,X-Request-ID,X-Unknown-Fields,If-None-Match"`
+	CORSMaxAge         time.Duration `envconfig:"CORS_MAX_AGE" default:"10m"`
 }
 
-func Load() (*Config, error) {
+// Load reads the configuration from the environment and, if configFile is
+// set, from that YAML file. Environment variables take precedence over the
+// file, which takes precedence over the defaults. Secrets can be read from
+// the file named by their variable suffixed with _FILE, see applySecretFiles.
+func Load(configFile string) (*Config, error) {
 	var cfg Config
 	if err := envconfig.Process("", &cfg); err != nil {
 		return nil, err
 	}
+	if configFile != "" {
+		if err := applyFile(&cfg, configFile); err != nil {
+			return nil, err
+		}
+	}
+	if err := applySecretFiles(&cfg); err != nil {
+		return nil, err
+	}
 	return &cfg, nil
 }
@@ -0,0 +1,74 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/config"
+)
+
+var _ = Describe("Load", func() {
+	writeFile := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+		Expect(os.WriteFile(path, []byte(content), 0o600)).To(Succeed())
+		return path
+	}
+
+	It("should use the defaults without a configuration file", func() {
+		cfg, err := config.Load("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.BindAddress).To(Equal("0.0.0.0:8080"))
+		// Browsers may send the request headers that the API defines
+		Expect(cfg.CORSAllowedHeaders).To(ContainElements("X-Unknown-Fields", "If-None-Match"))
+	})
+
+	It("should read the values of the configuration file", func() {
+		cfg, err := config.Load(writeFile(`
+bind_address: ":9090"
+log_level: debug
+shutdown_drain_timeout: 30s
+cors_allowed_origins: [https://a.example.com, https://b.example.com]
+role_bindings:
+  ci: editor
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.BindAddress).To(Equal(":9090"))
+		Expect(cfg.LogLevel).To(Equal("debug"))
+		Expect(cfg.ShutdownDrainTimeout).To(Equal(30 * time.Second))
+		Expect(cfg.CORSAllowedOrigins).To(Equal([]string{"https://a.example.com", "https://b.example.com"}))
+		Expect(cfg.RoleBindings).To(Equal(map[string]string{"ci": "editor"}))
+		Expect(cfg.MirrorPercent).To(Equal(10.0))
+	})
+
+	It("should let environment variables override the configuration file", func() {
+		GinkgoT().Setenv("BIND_ADDRESS", ":7070")
+
+		cfg, err := config.Load(writeFile(`bind_address: ":9090"`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cfg.BindAddress).To(Equal(":7070"))
+	})
+
+	It("should accept an empty configuration file", func() {
+		_, err := config.Load(writeFile(""))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject unknown keys", func() {
+		_, err := config.Load(writeFile("log_level: info\nbind_adress: \":9090\"\n"))
+		Expect(err).To(MatchError(ContainSubstring(`line 2: unknown configuration key "bind_adress"`)))
+	})
+
+	It("should point at the key with an invalid value", func() {
+		_, err := config.Load(writeFile("mirror_percent: ten\n"))
+		Expect(err).To(MatchError(ContainSubstring(`line 1: invalid value for "mirror_percent"`)))
+	})
+
+	It("should fail when the configuration file does not exist", func() {
+		_, err := config.Load(filepath.Join(GinkgoT().TempDir(), "missing.yaml"))
+		Expect(err).To(MatchError(ContainSubstring("failed to read configuration file")))
+	})
+})
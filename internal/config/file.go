@@ -0,0 +1,73 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// applyFile sets the fields of cfg from the YAML file at path, except for
+// those whose environment variable is set, which take precedence. Keys are
+// the lowercase names of the environment variables, e.g. bind_address for
+// BIND_ADDRESS, and lists and maps are written as YAML sequences and
+// mappings. Errors point at the offending key and line.
+func applyFile(cfg *Config, path string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read configuration file: %w", err)
+	}
+
+	var document yaml.Node
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
+	if err := decoder.Decode(&document); err != nil {
+		if errors.Is(err, io.EOF) {
+			// Empty file
+			return nil
+		}
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	if len(document.Content) == 0 {
+		return nil
+	}
+	root := document.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("%s: line %d: configuration must be a mapping of keys to values", path, root.Line)
+	}
+
+	fields := fieldsByKey(cfg)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+		field, ok := fields[key.Value]
+		if !ok {
+			return fmt.Errorf("%s: line %d: unknown configuration key %q", path, key.Line, key.Value)
+		}
+		if _, set := os.LookupEnv(strings.ToUpper(key.Value)); set {
+			continue
+		}
+		// Decode into a fresh value so that lists and maps replace the defaults
+		decoded := reflect.New(field.Type())
+		if err := value.Decode(decoded.Interface()); err != nil {
+			return fmt.Errorf("%s: line %d: invalid value for %q: %w", path, value.Line, key.Value, err)
+		}
+		field.Set(decoded.Elem())
+	}
+	return nil
+}
+
+// fieldsByKey returns the fields of cfg by configuration file key.
+func fieldsByKey(cfg *Config) map[string]reflect.Value {
+	fields := map[string]reflect.Value{}
+	value := reflect.ValueOf(cfg).Elem()
+	for i := range value.NumField() {
+		if name := value.Type().Field(i).Tag.Get("envconfig"); name != "" {
+			fields[strings.ToLower(name)] = value.Field(i)
+		}
+	}
+	return fields
+}
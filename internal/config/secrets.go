@@ -0,0 +1,43 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// secretFileSuffix is appended to the environment variable of a secret to
+// read its value from a file instead, e.g. a Kubernetes or Podman secret
+// mounted in the container, so that it does not appear in the environment.
+const secretFileSuffix = "_FILE"
+
+// applySecretFiles sets the fields of cfg tagged with secret:"true" from the
+// file named by their environment variable suffixed with _FILE, e.g.
+// MIRROR_URL_FILE for MIRROR_URL, ignoring trailing newlines. Such a file
+// takes precedence over the configuration file, and setting both the
+// variable and its _FILE variant is an error.
+func applySecretFiles(cfg *Config) error {
+	value := reflect.ValueOf(cfg).Elem()
+	for i := range value.NumField() {
+		field := value.Type().Field(i)
+		if field.Tag.Get("secret") != "true" {
+			continue
+		}
+		name := field.Tag.Get("envconfig")
+		fileVar := name + secretFileSuffix
+		path := os.Getenv(fileVar)
+		if path == "" {
+			continue
+		}
+		if _, set := os.LookupEnv(name); set {
+			return fmt.Errorf("%s and %s are mutually exclusive", name, fileVar)
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", fileVar, err)
+		}
+		value.Field(i).SetString(strings.TrimRight(string(content), "\r\n"))
+	}
+	return nil
+}
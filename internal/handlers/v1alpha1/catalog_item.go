@@ -66,3 +66,99 @@ func (h *Handler) DeleteCatalogItem(ctx context.Context, request server.DeleteCa
 		},
 	}, nil
 }
+
+func (h *Handler) PreviewCatalogItem(ctx context.Context, request server.PreviewCatalogItemRequestObject) (server.PreviewCatalogItemResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.PreviewCatalogItem500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) GetCatalogItemValidationBundle(ctx context.Context, request server.GetCatalogItemValidationBundleRequestObject) (server.GetCatalogItemValidationBundleResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.GetCatalogItemValidationBundle500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) ExportBackstageCatalogItems(ctx context.Context, request server.ExportBackstageCatalogItemsRequestObject) (server.ExportBackstageCatalogItemsResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.ExportBackstageCatalogItems500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) PublishCatalogItem(ctx context.Context, request server.PublishCatalogItemRequestObject) (server.PublishCatalogItemResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.PublishCatalogItem500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) ArchiveCatalogItem(ctx context.Context, request server.ArchiveCatalogItemRequestObject) (server.ArchiveCatalogItemResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.ArchiveCatalogItem500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) ListCatalogItemCategories(ctx context.Context, request server.ListCatalogItemCategoriesRequestObject) (server.ListCatalogItemCategoriesResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.ListCatalogItemCategories500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) GetCatalogItemIcon(ctx context.Context, request server.GetCatalogItemIconRequestObject) (server.GetCatalogItemIconResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.GetCatalogItemIcon500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) EstimateCatalogItem(ctx context.Context, request server.EstimateCatalogItemRequestObject) (server.EstimateCatalogItemResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.EstimateCatalogItem500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
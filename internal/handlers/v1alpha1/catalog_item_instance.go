@@ -43,6 +43,18 @@ func (h *Handler) GetCatalogItemInstance(ctx context.Context, request server.Get
 	}, nil
 }
 
+func (h *Handler) UpdateCatalogItemInstance(ctx context.Context, request server.UpdateCatalogItemInstanceRequestObject) (server.UpdateCatalogItemInstanceResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.UpdateCatalogItemInstance500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
 func (h *Handler) DeleteCatalogItemInstance(ctx context.Context, request server.DeleteCatalogItemInstanceRequestObject) (server.DeleteCatalogItemInstanceResponseObject, error) {
 	detail := "endpoint not implemented"
 	return server.DeleteCatalogItemInstance500JSONResponse{
@@ -54,3 +66,39 @@ func (h *Handler) DeleteCatalogItemInstance(ctx context.Context, request server.
 		},
 	}, nil
 }
+
+func (h *Handler) UpdateCatalogItemInstanceStatus(ctx context.Context, request server.UpdateCatalogItemInstanceStatusRequestObject) (server.UpdateCatalogItemInstanceStatusResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.UpdateCatalogItemInstanceStatus500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) DescribeCatalogItemInstance(ctx context.Context, request server.DescribeCatalogItemInstanceRequestObject) (server.DescribeCatalogItemInstanceResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.DescribeCatalogItemInstance500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
+
+func (h *Handler) CloneCatalogItemInstance(ctx context.Context, request server.CloneCatalogItemInstanceRequestObject) (server.CloneCatalogItemInstanceResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.CloneCatalogItemInstance500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
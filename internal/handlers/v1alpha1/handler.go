@@ -1,7 +1,10 @@
 package v1alpha1
 
 import (
+	"time"
+
 	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/health"
 )
 
 const (
@@ -10,10 +13,15 @@ const (
 
 type Handler struct {
 	// Future: storage layer will be injected here
+	checker   *health.Checker
+	startTime time.Time
 }
 
-func NewHandler() *Handler {
-	return &Handler{}
+func NewHandler(checker *health.Checker) *Handler {
+	return &Handler{
+		checker:   checker,
+		startTime: time.Now(),
+	}
 }
 
 // Compile-time verification
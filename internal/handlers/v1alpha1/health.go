@@ -3,15 +3,51 @@ package v1alpha1
 import (
 	"context"
 	"fmt"
+	"time"
 
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+const (
+	statusHealthy   = "healthy"
+	statusUnhealthy = "unhealthy"
 )
 
 func (h *Handler) GetHealth(ctx context.Context, request server.GetHealthRequestObject) (server.GetHealthResponseObject, error) {
-	status := "healthy"
+	status := statusHealthy
 	path := fmt.Sprintf("%shealth", apiPrefix)
-	return server.GetHealth200JSONResponse{
+	response := server.GetHealth200JSONResponse{
 		Status: status,
 		Path:   &path,
-	}, nil
+	}
+
+	if request.Params.View == nil || *request.Params.View != v1alpha1.HealthViewFull {
+		return response, nil
+	}
+
+	uptime := int64(time.Since(h.startTime).Seconds())
+	results := h.checker.Run(ctx)
+	components := make([]v1alpha1.HealthComponent, 0, len(results))
+	for _, result := range results {
+		latency := float64(result.Duration) / float64(time.Millisecond)
+		component := v1alpha1.HealthComponent{
+			Name:      result.Name,
+			Status:    statusHealthy,
+			LatencyMs: &latency,
+		}
+		if result.Err != nil {
+			message := result.Err.Error()
+			component.Status = statusUnhealthy
+			component.Message = &message
+		}
+		components = append(components, component)
+	}
+	if !health.Healthy(results) {
+		response.Status = statusUnhealthy
+	}
+	response.UptimeSeconds = &uptime
+	response.Components = &components
+	return response, nil
 }
@@ -2,19 +2,27 @@ package v1alpha1_test
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	api "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/dcm-project/catalog-manager/internal/api/server"
 	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/health"
 )
 
 var _ = Describe("Health Handler", func() {
-	var handler *v1alpha1.Handler
+	var (
+		handler *v1alpha1.Handler
+		checker *health.Checker
+	)
 
 	BeforeEach(func() {
-		handler = v1alpha1.NewHandler()
+		checker = health.NewChecker(time.Second)
+		handler = v1alpha1.NewHandler(checker)
 	})
 
 	Describe("GetHealth", func() {
@@ -29,6 +37,42 @@ var _ = Describe("Health Handler", func() {
 			Expect(healthResponse.Status).To(Equal("healthy"))
 			Expect(healthResponse.Path).ToNot(BeNil())
 			Expect(*healthResponse.Path).To(Equal("/api/v1alpha1/health"))
+			Expect(healthResponse.Components).To(BeNil())
+			Expect(healthResponse.UptimeSeconds).To(BeNil())
+		})
+
+		It("should not run dependency checks in the basic view", func() {
+			checker.Register("database", func(ctx context.Context) error { return errors.New("connection refused") })
+
+			response, err := handler.GetHealth(context.Background(), server.GetHealthRequestObject{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response.(server.GetHealth200JSONResponse).Status).To(Equal("healthy"))
+		})
+
+		It("should report component details in the full view", func() {
+			checker.Register("database", func(ctx context.Context) error { return nil })
+			checker.Register("provider", func(ctx context.Context) error { return errors.New("connection refused") })
+
+			view := api.HealthViewFull
+			response, err := handler.GetHealth(context.Background(), server.GetHealthRequestObject{
+				Params: api.GetHealthParams{View: &view},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			healthResponse := response.(server.GetHealth200JSONResponse)
+			Expect(healthResponse.Status).To(Equal("unhealthy"))
+			Expect(healthResponse.UptimeSeconds).ToNot(BeNil())
+			Expect(*healthResponse.Components).To(HaveLen(2))
+
+			database := (*healthResponse.Components)[0]
+			Expect(database.Name).To(Equal("database"))
+			Expect(database.Status).To(Equal("healthy"))
+			Expect(database.LatencyMs).ToNot(BeNil())
+
+			provider := (*healthResponse.Components)[1]
+			Expect(provider.Status).To(Equal("unhealthy"))
+			Expect(*provider.Message).To(Equal("connection refused"))
 		})
 	})
 })
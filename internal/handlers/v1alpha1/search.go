@@ -0,0 +1,20 @@
+package v1alpha1
+
+import (
+	"context"
+
+	v1alpha1 "github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+)
+
+func (h *Handler) Search(ctx context.Context, request server.SearchRequestObject) (server.SearchResponseObject, error) {
+	detail := "endpoint not implemented"
+	return server.Search500JSONResponse{
+		InternalServerErrorJSONResponse: server.InternalServerErrorJSONResponse{
+			Type:   v1alpha1.UNIMPLEMENTED,
+			Status: 500,
+			Title:  "Not Implemented",
+			Detail: &detail,
+		},
+	}, nil
+}
@@ -0,0 +1,21 @@
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	"github.com/dcm-project/catalog-manager/internal/version"
+)
+
+func (h *Handler) GetVersion(ctx context.Context, request server.GetVersionRequestObject) (server.GetVersionResponseObject, error) {
+	path := fmt.Sprintf("%sversion", apiPrefix)
+	return server.GetVersion200JSONResponse{
+		Version:   version.Version,
+		GitCommit: version.GitCommit,
+		BuildDate: version.BuildDate,
+		GoVersion: version.GoVersion(),
+		Platform:  version.Platform(),
+		Path:      &path,
+	}, nil
+}
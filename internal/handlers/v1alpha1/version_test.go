@@ -0,0 +1,38 @@
+package v1alpha1_test
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/api/server"
+	v1alpha1 "github.com/dcm-project/catalog-manager/internal/handlers/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/health"
+	"github.com/dcm-project/catalog-manager/internal/version"
+)
+
+var _ = Describe("Version Handler", func() {
+	var handler *v1alpha1.Handler
+
+	BeforeEach(func() {
+		handler = v1alpha1.NewHandler(health.NewChecker(time.Second))
+	})
+
+	It("should return the build information", func() {
+		response, err := handler.GetVersion(context.Background(), server.GetVersionRequestObject{})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response).To(BeAssignableToTypeOf(server.GetVersion200JSONResponse{}))
+
+		versionResponse := response.(server.GetVersion200JSONResponse)
+		Expect(versionResponse.Version).To(Equal(version.Version))
+		Expect(versionResponse.GitCommit).To(Equal(version.GitCommit))
+		Expect(versionResponse.BuildDate).To(Equal(version.BuildDate))
+		Expect(versionResponse.GoVersion).To(Equal(runtime.Version()))
+		Expect(versionResponse.Platform).To(Equal(runtime.GOOS + "/" + runtime.GOARCH))
+		Expect(*versionResponse.Path).To(Equal("/api/v1alpha1/version"))
+	})
+})
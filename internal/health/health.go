@@ -0,0 +1,105 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Check reports whether a dependency is usable. It must honour ctx.
+type Check func(ctx context.Context) error
+
+// Result is the outcome of a single Check.
+type Result struct {
+	Name     string
+	Err      error
+	Duration time.Duration
+}
+
+// Checker runs the registered dependency checks concurrently, each bounded
+// by a timeout, and records their transitions in a History.
+type Checker struct {
+	timeout time.Duration
+	history *History
+
+	mu     sync.RWMutex
+	names  []string
+	checks map[string]Check
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+		history: NewHistory(defaultHistorySize),
+		checks:  map[string]Check{},
+	}
+}
+
+// History returns the transitions recorded by the runs of the checks.
+func (c *Checker) History() *History {
+	return c.history
+}
+
+// Register adds a named check, replacing any check with the same name.
+func (c *Checker) Register(name string, check Check) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.checks[name]; !ok {
+		c.names = append(c.names, name)
+	}
+	c.checks[name] = check
+}
+
+// Run executes all checks and returns their results in registration order.
+func (c *Checker) Run(ctx context.Context) []Result {
+	c.mu.RLock()
+	names := append([]string(nil), c.names...)
+	checks := make([]Check, len(names))
+	for i, name := range names {
+		checks[i] = c.checks[name]
+	}
+	c.mu.RUnlock()
+
+	results := make([]Result, len(names))
+	var wg sync.WaitGroup
+	for i := range names {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = c.run(ctx, names[i], checks[i])
+		}()
+	}
+	wg.Wait()
+	c.history.Record(results)
+	return results
+}
+
+func (c *Checker) run(ctx context.Context, name string, check Check) Result {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errCh:
+	case <-ctx.Done():
+		// Do not wait for checks that ignore their context
+		err = ctx.Err()
+	}
+	return Result{Name: name, Err: err, Duration: time.Since(start)}
+}
+
+// Healthy reports whether none of the results failed.
+func Healthy(results []Result) bool {
+	for _, result := range results {
+		if result.Err != nil {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+var _ = Describe("Checker", func() {
+	var checker *health.Checker
+
+	BeforeEach(func() {
+		checker = health.NewChecker(50 * time.Millisecond)
+	})
+
+	It("should be healthy without checks", func() {
+		results := checker.Run(context.Background())
+		Expect(results).To(BeEmpty())
+		Expect(health.Healthy(results)).To(BeTrue())
+	})
+
+	It("should report results in registration order", func() {
+		checker.Register("database", func(ctx context.Context) error { return nil })
+		checker.Register("provider", func(ctx context.Context) error { return errors.New("unreachable") })
+
+		results := checker.Run(context.Background())
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Name).To(Equal("database"))
+		Expect(results[0].Err).ToNot(HaveOccurred())
+		Expect(results[1].Name).To(Equal("provider"))
+		Expect(results[1].Err).To(MatchError("unreachable"))
+		Expect(health.Healthy(results)).To(BeFalse())
+	})
+
+	It("should time out slow checks", func() {
+		checker.Register("slow", func(ctx context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		})
+
+		start := time.Now()
+		results := checker.Run(context.Background())
+		Expect(time.Since(start)).To(BeNumerically("<", 500*time.Millisecond))
+		Expect(results[0].Err).To(MatchError(context.DeadlineExceeded))
+	})
+
+	It("should replace checks registered under the same name", func() {
+		checker.Register("database", func(ctx context.Context) error { return errors.New("down") })
+		checker.Register("database", func(ctx context.Context) error { return nil })
+
+		results := checker.Run(context.Background())
+		Expect(results).To(HaveLen(1))
+		Expect(health.Healthy(results)).To(BeTrue())
+	})
+})
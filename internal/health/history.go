@@ -0,0 +1,89 @@
+package health
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultHistorySize is the number of transitions kept by a Checker
+const defaultHistorySize = 100
+
+// Transition is a check switching between passing and failing.
+type Transition struct {
+	Name    string
+	Time    time.Time
+	Healthy bool
+	// Error is the failure that caused the transition, if any
+	Error string
+}
+
+// History keeps the most recent transitions of the checks, so that
+// intermittent failures remain visible after the checks recover.
+type History struct {
+	size int
+
+	mu          sync.Mutex
+	transitions []Transition
+	healthy     map[string]bool
+}
+
+func NewHistory(size int) *History {
+	return &History{
+		size:    size,
+		healthy: map[string]bool{},
+	}
+}
+
+// Record adds a transition for every result whose state differs from the
+// previous result of the same check. The first result of a check is only
+// recorded when it fails, checks being assumed healthy until proven
+// otherwise.
+func (h *History) Record(results []Result) {
+	now := time.Now()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, result := range results {
+		healthy := result.Err == nil
+		previous, seen := h.healthy[result.Name]
+		h.healthy[result.Name] = healthy
+		if (seen && previous == healthy) || (!seen && healthy) {
+			continue
+		}
+
+		transition := Transition{Name: result.Name, Time: now, Healthy: healthy}
+		if !healthy {
+			transition.Error = result.Err.Error()
+		}
+		h.transitions = append(h.transitions, transition)
+		if len(h.transitions) > h.size {
+			h.transitions = h.transitions[len(h.transitions)-h.size:]
+		}
+	}
+}
+
+// Transitions returns the recorded transitions, oldest first.
+func (h *History) Transitions() []Transition {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return append([]Transition(nil), h.transitions...)
+}
+
+// Flapping returns the names of the checks that transitioned at least
+// threshold times within the last window, in order of their first
+// transition.
+func (h *History) Flapping(window time.Duration, threshold int) []string {
+	since := time.Now().Add(-window)
+	counts := map[string]int{}
+	var names []string
+	for _, transition := range h.Transitions() {
+		if transition.Time.Before(since) {
+			continue
+		}
+		counts[transition.Name]++
+		if counts[transition.Name] == threshold {
+			names = append(names, transition.Name)
+		}
+	}
+	return names
+}
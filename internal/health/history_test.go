@@ -0,0 +1,62 @@
+package health_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/health"
+)
+
+var _ = Describe("History", func() {
+	var history *health.History
+
+	passing := health.Result{Name: "database"}
+	failing := health.Result{Name: "database", Err: errors.New("down")}
+
+	BeforeEach(func() {
+		history = health.NewHistory(3)
+	})
+
+	It("should not record checks that start healthy", func() {
+		history.Record([]health.Result{passing})
+		history.Record([]health.Result{passing})
+
+		Expect(history.Transitions()).To(BeEmpty())
+	})
+
+	It("should record state changes", func() {
+		history.Record([]health.Result{passing})
+		history.Record([]health.Result{failing})
+		history.Record([]health.Result{failing})
+		history.Record([]health.Result{passing})
+
+		transitions := history.Transitions()
+		Expect(transitions).To(HaveLen(2))
+		Expect(transitions[0].Healthy).To(BeFalse())
+		Expect(transitions[0].Error).To(Equal("down"))
+		Expect(transitions[1].Healthy).To(BeTrue())
+		Expect(transitions[1].Error).To(BeEmpty())
+	})
+
+	It("should keep only the most recent transitions", func() {
+		for range 5 {
+			history.Record([]health.Result{failing})
+			history.Record([]health.Result{passing})
+		}
+
+		Expect(history.Transitions()).To(HaveLen(3))
+		Expect(history.Transitions()[2].Healthy).To(BeTrue())
+	})
+
+	It("should detect flapping checks", func() {
+		history.Record([]health.Result{failing, {Name: "provider"}})
+		history.Record([]health.Result{passing, {Name: "provider"}})
+
+		Expect(history.Flapping(time.Minute, 2)).To(ConsistOf("database"))
+		Expect(history.Flapping(time.Minute, 3)).To(BeEmpty())
+		Expect(history.Flapping(0, 2)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,148 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+)
+
+const (
+	// specPathPrefix is the prefix of field paths into a service type spec
+	specPathPrefix = "spec."
+	// maxDisplayNameLength is the limit of FieldConfiguration.display_name
+	maxDisplayNameLength = 63
+	// maxRefDepth and maxPathDepth bound the $ref chains followed and the
+	// nesting of properties, to stop on recursive schemas
+	maxRefDepth  = 32
+	maxPathDepth = 32
+)
+
+// annotationKeywords are the schema keywords that become part of the field
+// configuration itself rather than of its validation schema.
+var annotationKeywords = []string{"$schema", "$id", "title", "default", "definitions", "$defs"}
+
+// FieldsFromHelmSchema generates the field configurations of a catalog item
+// from the values.schema.json of a Helm chart. Each leaf of the values, i.e.
+// each property that is not an object with properties of its own, becomes an
+// editable field at spec.<values path>, with the title of the property as
+// display name, its default, and the rest of its schema as validation
+// schema. Read-only properties are fixed to their default. Local $refs are
+// resolved; fields are sorted by path.
+func FieldsFromHelmSchema(content []byte) ([]v1alpha1.FieldConfiguration, error) {
+	var root map[string]any
+	if err := json.Unmarshal(content, &root); err != nil {
+		return nil, fmt.Errorf("invalid values schema: %w", err)
+	}
+
+	walker := &schemaWalker{root: root}
+	if err := walker.walk(nil, root, 0); err != nil {
+		return nil, err
+	}
+	if len(walker.fields) == 0 {
+		return nil, errors.New("the values schema defines no properties")
+	}
+	slices.SortFunc(walker.fields, func(a, b v1alpha1.FieldConfiguration) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+	return walker.fields, nil
+}
+
+type schemaWalker struct {
+	root   map[string]any
+	fields []v1alpha1.FieldConfiguration
+}
+
+func (w *schemaWalker) walk(path []string, schema map[string]any, depth int) error {
+	if len(path) > maxPathDepth {
+		return fmt.Errorf("%s: properties are too deeply nested or recursive", strings.Join(path, "."))
+	}
+	schema, err := w.resolve(schema, depth)
+	if err != nil {
+		return fmt.Errorf("%s: %w", strings.Join(path, "."), err)
+	}
+
+	properties, _ := schema["properties"].(map[string]any)
+	if len(properties) == 0 {
+		if len(path) > 0 {
+			w.fields = append(w.fields, field(path, schema))
+		}
+		return nil
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(properties)) {
+		if strings.Contains(name, ".") {
+			return fmt.Errorf("property %q cannot be addressed with a dot-separated path", strings.Join(append(path, name), "."))
+		}
+		property, ok := properties[name].(map[string]any)
+		if !ok {
+			continue
+		}
+		if err := w.walk(append(slices.Clone(path), name), property, 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolve follows the local $ref of schema, if any.
+func (w *schemaWalker) resolve(schema map[string]any, depth int) (map[string]any, error) {
+	ref, ok := schema["$ref"].(string)
+	if !ok {
+		return schema, nil
+	}
+	if depth >= maxRefDepth {
+		return nil, fmt.Errorf("$ref %s is too deeply nested or circular", ref)
+	}
+	if !strings.HasPrefix(ref, "#/") {
+		return nil, fmt.Errorf("only local $refs are supported, got %s", ref)
+	}
+
+	var target any = w.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+		object, ok := target.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("$ref %s not found", ref)
+		}
+		if target, ok = object[token]; !ok {
+			return nil, fmt.Errorf("$ref %s not found", ref)
+		}
+	}
+	resolved, ok := target.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("$ref %s is not a schema", ref)
+	}
+	return w.resolve(resolved, depth+1)
+}
+
+func field(path []string, schema map[string]any) v1alpha1.FieldConfiguration {
+	editable := true
+	if readOnly, _ := schema["readOnly"].(bool); readOnly {
+		editable = false
+	}
+	configuration := v1alpha1.FieldConfiguration{
+		Path:     specPathPrefix + strings.Join(path, "."),
+		Default:  schema["default"],
+		Editable: &editable,
+	}
+	if title, ok := schema["title"].(string); ok && title != "" {
+		if runes := []rune(title); len(runes) > maxDisplayNameLength {
+			title = string(runes[:maxDisplayNameLength])
+		}
+		configuration.DisplayName = &title
+	}
+
+	validation := maps.Clone(schema)
+	for _, keyword := range append(annotationKeywords, "readOnly") {
+		delete(validation, keyword)
+	}
+	if editable && len(validation) > 0 {
+		configuration.ValidationSchema = &validation
+	}
+	return configuration
+}
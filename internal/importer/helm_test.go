@@ -0,0 +1,85 @@
+package importer_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/importer"
+)
+
+var _ = Describe("FieldsFromHelmSchema", func() {
+	It("should turn each leaf of the values into a field", func() {
+		fields, err := importer.FieldsFromHelmSchema([]byte(`{
+			"$schema": "http://json-schema.org/draft-07/schema#",
+			"type": "object",
+			"properties": {
+				"replicaCount": {"type": "integer", "title": "Replicas", "minimum": 1, "default": 1},
+				"image": {
+					"type": "object",
+					"properties": {
+						"repository": {"type": "string", "default": "nginx"},
+						"pullPolicy": {"type": "string", "enum": ["Always", "IfNotPresent"], "readOnly": true, "default": "IfNotPresent"}
+					}
+				},
+				"podAnnotations": {"type": "object", "additionalProperties": {"type": "string"}}
+			}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+
+		paths := make([]string, len(fields))
+		for i, field := range fields {
+			paths[i] = field.Path
+		}
+		Expect(paths).To(Equal([]string{
+			"spec.image.pullPolicy", "spec.image.repository", "spec.podAnnotations", "spec.replicaCount",
+		}))
+
+		pullPolicy := fields[0]
+		Expect(*pullPolicy.Editable).To(BeFalse())
+		Expect(pullPolicy.Default).To(Equal("IfNotPresent"))
+		Expect(pullPolicy.ValidationSchema).To(BeNil())
+
+		replicas := fields[3]
+		Expect(*replicas.Editable).To(BeTrue())
+		Expect(*replicas.DisplayName).To(Equal("Replicas"))
+		Expect(replicas.Default).To(BeEquivalentTo(1))
+		Expect(*replicas.ValidationSchema).To(Equal(map[string]any{"type": "integer", "minimum": float64(1)}))
+	})
+
+	It("should resolve local references", func() {
+		fields, err := importer.FieldsFromHelmSchema([]byte(`{
+			"definitions": {"port": {"type": "integer", "maximum": 65535}},
+			"properties": {"service": {"properties": {"port": {"$ref": "#/definitions/port"}}}}
+		}`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fields).To(HaveLen(1))
+		Expect(fields[0].Path).To(Equal("spec.service.port"))
+		Expect(*fields[0].ValidationSchema).To(HaveKeyWithValue("maximum", float64(65535)))
+	})
+
+	It("should reject circular references", func() {
+		_, err := importer.FieldsFromHelmSchema([]byte(`{
+			"definitions": {"a": {"$ref": "#/definitions/b"}, "b": {"$ref": "#/definitions/a"}},
+			"properties": {"loop": {"$ref": "#/definitions/a"}}
+		}`))
+		Expect(err).To(MatchError(ContainSubstring("too deeply nested or circular")))
+	})
+
+	It("should reject recursive schemas", func() {
+		_, err := importer.FieldsFromHelmSchema([]byte(`{
+			"definitions": {"node": {"properties": {"child": {"$ref": "#/definitions/node"}}}},
+			"properties": {"tree": {"$ref": "#/definitions/node"}}
+		}`))
+		Expect(err).To(MatchError(ContainSubstring("too deeply nested or recursive")))
+	})
+
+	It("should reject properties that cannot be addressed by path", func() {
+		_, err := importer.FieldsFromHelmSchema([]byte(`{"properties": {"app.kubernetes.io/name": {"type": "string"}}}`))
+		Expect(err).To(MatchError(ContainSubstring("cannot be addressed")))
+	})
+
+	It("should reject schemas without properties", func() {
+		_, err := importer.FieldsFromHelmSchema([]byte(`{"type": "object"}`))
+		Expect(err).To(MatchError(ContainSubstring("defines no properties")))
+	})
+})
@@ -0,0 +1,148 @@
+package provisioner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/logging"
+)
+
+// ErrQueueFull is returned by Enqueue when the pool cannot accept more work
+var ErrQueueFull = errors.New("provisioning queue is full")
+
+// Provisioner creates the resources backing a catalog item instance.
+// Implementations must be idempotent: Provision is retried on failure.
+type Provisioner interface {
+	Provision(ctx context.Context, instance v1alpha1.CatalogItemInstance) ([]v1alpha1.ExternalReference, error)
+}
+
+// StatusUpdater persists status transitions of catalog item instances.
+type StatusUpdater interface {
+	UpdateStatus(ctx context.Context, instanceID string, status v1alpha1.CatalogItemInstanceStatus) error
+}
+
+type Config struct {
+	Workers        int
+	QueueSize      int
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Workers:        4,
+		QueueSize:      100,
+		MaxAttempts:    5,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	}
+}
+
+// Pool runs a fixed number of workers that provision enqueued instances and
+// report their progress (PROVISIONING -> READY/FAILED) through a StatusUpdater.
+type Pool struct {
+	provisioner Provisioner
+	updater     StatusUpdater
+	config      Config
+	queue       chan v1alpha1.CatalogItemInstance
+}
+
+func NewPool(provisioner Provisioner, updater StatusUpdater, cfg Config) *Pool {
+	return &Pool{
+		provisioner: provisioner,
+		updater:     updater,
+		config:      cfg,
+		queue:       make(chan v1alpha1.CatalogItemInstance, cfg.QueueSize),
+	}
+}
+
+// Enqueue schedules an instance for provisioning without blocking.
+func (p *Pool) Enqueue(instance v1alpha1.CatalogItemInstance) error {
+	if instance.Uid == nil || *instance.Uid == "" {
+		return errors.New("instance has no uid")
+	}
+	select {
+	case p.queue <- instance:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+// Run starts the workers and blocks until ctx is cancelled and all of them
+// have returned.
+func (p *Pool) Run(ctx context.Context) {
+	var wg sync.WaitGroup
+	for range p.config.Workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case instance := <-p.queue:
+					p.process(ctx, instance)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func (p *Pool) process(ctx context.Context, instance v1alpha1.CatalogItemInstance) {
+	id := *instance.Uid
+	logger := logging.FromContext(ctx).With("instance", id)
+	p.setStatus(ctx, id, v1alpha1.CatalogItemInstanceStatus{Phase: v1alpha1.PROVISIONING})
+
+	backoff := p.config.InitialBackoff
+	var lastErr error
+	for attempt := 1; attempt <= p.config.MaxAttempts; attempt++ {
+		refs, err := p.provisioner.Provision(ctx, instance)
+		if err == nil {
+			p.setStatus(ctx, id, v1alpha1.CatalogItemInstanceStatus{
+				Phase:              v1alpha1.READY,
+				ExternalReferences: &refs,
+			})
+			return
+		}
+		lastErr = err
+		if attempt == p.config.MaxAttempts {
+			break
+		}
+
+		message := fmt.Sprintf("attempt %d/%d failed: %v; retrying in %s", attempt, p.config.MaxAttempts, err, backoff)
+		logger.Warn("Provisioning attempt failed", "attempt", attempt, "error", err, "backoff", backoff)
+		p.setStatus(ctx, id, v1alpha1.CatalogItemInstanceStatus{
+			Phase:   v1alpha1.PROVISIONING,
+			Message: &message,
+		})
+
+		select {
+		case <-ctx.Done():
+			// Leave the instance in PROVISIONING so it is picked up again
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, p.config.MaxBackoff)
+	}
+
+	message := fmt.Sprintf("provisioning failed after %d attempts: %v", p.config.MaxAttempts, lastErr)
+	logger.Error("Provisioning failed", "attempts", p.config.MaxAttempts, "error", lastErr)
+	p.setStatus(ctx, id, v1alpha1.CatalogItemInstanceStatus{
+		Phase:   v1alpha1.FAILED,
+		Message: &message,
+	})
+}
+
+func (p *Pool) setStatus(ctx context.Context, id string, status v1alpha1.CatalogItemInstanceStatus) {
+	if err := p.updater.UpdateStatus(ctx, id, status); err != nil {
+		logging.FromContext(ctx).Error("Failed to update instance status",
+			"instance", id, "phase", status.Phase, "error", err)
+	}
+}
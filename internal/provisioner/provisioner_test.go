@@ -0,0 +1,119 @@
+package provisioner_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/internal/provisioner"
+)
+
+type fakeProvisioner struct {
+	mu       sync.Mutex
+	calls    int
+	failures int
+}
+
+func (f *fakeProvisioner) Provision(ctx context.Context, instance v1alpha1.CatalogItemInstance) ([]v1alpha1.ExternalReference, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls++
+	if f.calls <= f.failures {
+		return nil, errors.New("backend unavailable")
+	}
+	return []v1alpha1.ExternalReference{{Kind: "VirtualMachine", Id: "vm-1"}}, nil
+}
+
+type recordingUpdater struct {
+	mu     sync.Mutex
+	phases []v1alpha1.CatalogItemInstancePhase
+	last   v1alpha1.CatalogItemInstanceStatus
+}
+
+func (r *recordingUpdater) UpdateStatus(ctx context.Context, instanceID string, status v1alpha1.CatalogItemInstanceStatus) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.phases = append(r.phases, status.Phase)
+	r.last = status
+	return nil
+}
+
+func (r *recordingUpdater) lastPhase() v1alpha1.CatalogItemInstancePhase {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.last.Phase
+}
+
+var _ = Describe("Pool", func() {
+	var (
+		fake    *fakeProvisioner
+		updater *recordingUpdater
+		pool    *provisioner.Pool
+		cancel  context.CancelFunc
+		done    chan struct{}
+	)
+
+	instance := func(id string) v1alpha1.CatalogItemInstance {
+		return v1alpha1.CatalogItemInstance{Uid: &id}
+	}
+
+	start := func(failures int) {
+		fake = &fakeProvisioner{failures: failures}
+		updater = &recordingUpdater{}
+		pool = provisioner.NewPool(fake, updater, provisioner.Config{
+			Workers:        1,
+			QueueSize:      1,
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     time.Millisecond,
+		})
+
+		var ctx context.Context
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan struct{})
+		go func() {
+			defer close(done)
+			pool.Run(ctx)
+		}()
+	}
+
+	AfterEach(func() {
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("should transition a provisioned instance to READY", func() {
+		start(0)
+		Expect(pool.Enqueue(instance("vm-a"))).To(Succeed())
+
+		Eventually(updater.lastPhase).Should(Equal(v1alpha1.READY))
+		Expect(updater.phases).To(Equal([]v1alpha1.CatalogItemInstancePhase{v1alpha1.PROVISIONING, v1alpha1.READY}))
+		Expect(*updater.last.ExternalReferences).To(HaveLen(1))
+	})
+
+	It("should retry failed attempts", func() {
+		start(2)
+		Expect(pool.Enqueue(instance("vm-a"))).To(Succeed())
+
+		Eventually(updater.lastPhase).Should(Equal(v1alpha1.READY))
+		Expect(fake.calls).To(Equal(3))
+	})
+
+	It("should mark the instance FAILED once attempts are exhausted", func() {
+		start(3)
+		Expect(pool.Enqueue(instance("vm-a"))).To(Succeed())
+
+		Eventually(updater.lastPhase).Should(Equal(v1alpha1.FAILED))
+		Expect(*updater.last.Message).To(ContainSubstring("backend unavailable"))
+	})
+
+	It("should reject instances without a uid", func() {
+		start(0)
+		Expect(pool.Enqueue(v1alpha1.CatalogItemInstance{})).ToNot(Succeed())
+	})
+})
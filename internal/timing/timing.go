@@ -0,0 +1,66 @@
+package timing
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+type contextKey struct{}
+
+// Recorder accumulates the time a request spends in each server layer
+// (handler, service, store).
+type Recorder struct {
+	mu     sync.Mutex
+	layers []string
+	totals map[string]time.Duration
+}
+
+// WithRecorder returns a context carrying a new Recorder.
+func WithRecorder(ctx context.Context) (context.Context, *Recorder) {
+	r := &Recorder{totals: map[string]time.Duration{}}
+	return context.WithValue(ctx, contextKey{}, r), r
+}
+
+// Track starts timing layer and returns a function that stops it.
+// It is a no-op when ctx carries no Recorder.
+//
+//	defer timing.Track(ctx, "store")()
+func Track(ctx context.Context, layer string) func() {
+	r, ok := ctx.Value(contextKey{}).(*Recorder)
+	if !ok {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		r.add(layer, time.Since(start))
+	}
+}
+
+func (r *Recorder) add(layer string, d time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if _, ok := r.totals[layer]; !ok {
+		r.layers = append(r.layers, layer)
+	}
+	r.totals[layer] += d
+}
+
+// ServerTiming renders the recorded layers as a Server-Timing header value,
+// in the order they were first recorded.
+func (r *Recorder) ServerTiming() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	metrics := make([]string, 0, len(r.layers))
+	for _, layer := range r.layers {
+		metrics = append(metrics, fmt.Sprintf("%s;dur=%s", layer, Milliseconds(r.totals[layer])))
+	}
+	return strings.Join(metrics, ", ")
+}
+
+// Milliseconds formats d as a decimal number of milliseconds.
+func Milliseconds(d time.Duration) string {
+	return fmt.Sprintf("%.3f", float64(d)/float64(time.Millisecond))
+}
@@ -0,0 +1,102 @@
+"use strict";
+
+const apiBase = "/api/v1alpha1";
+
+const columns = {
+  "service-types": [
+    ["ID", (r) => r.uid],
+    ["Service Type", (r) => r.service_type],
+    ["API Version", (r) => r.api_version],
+    ["Updated", (r) => r.update_time],
+  ],
+  "catalog-items": [
+    ["ID", (r) => r.uid],
+    ["Display Name", (r) => r.display_name],
+    ["Service Type", (r) => r.spec && r.spec.service_type],
+    ["Updated", (r) => r.update_time],
+  ],
+  "catalog-item-instances": [
+    ["ID", (r) => r.uid],
+    ["Display Name", (r) => r.display_name],
+    ["Catalog Item", (r) => r.spec && r.spec.catalog_item_id],
+    ["Updated", (r) => r.update_time],
+  ],
+};
+
+const statusEl = document.getElementById("status");
+const headEl = document.querySelector("#results thead");
+const bodyEl = document.querySelector("#results tbody");
+const moreEl = document.getElementById("more");
+const detailEl = document.getElementById("detail");
+
+let current = "service-types";
+let nextPageToken = "";
+
+function cell(tag, text) {
+  const el = document.createElement(tag);
+  el.textContent = text === undefined || text === null ? "" : String(text);
+  return el;
+}
+
+function renderHead() {
+  const row = document.createElement("tr");
+  for (const [title] of columns[current]) {
+    row.appendChild(cell("th", title));
+  }
+  headEl.replaceChildren(row);
+}
+
+function renderRows(results) {
+  for (const resource of results) {
+    const row = document.createElement("tr");
+    for (const [, value] of columns[current]) {
+      row.appendChild(cell("td", value(resource)));
+    }
+    row.addEventListener("click", () => {
+      detailEl.textContent = JSON.stringify(resource, null, 2);
+      detailEl.hidden = false;
+    });
+    bodyEl.appendChild(row);
+  }
+}
+
+async function load(pageToken) {
+  const params = new URLSearchParams();
+  if (pageToken) {
+    params.set("page_token", pageToken);
+  }
+  statusEl.textContent = "";
+  try {
+    const response = await fetch(`${apiBase}/${current}?${params}`);
+    const body = await response.json();
+    if (!response.ok) {
+      statusEl.textContent = `${body.title || response.statusText}: ${body.detail || ""}`;
+      moreEl.hidden = true;
+      return;
+    }
+    renderRows(body.results || []);
+    nextPageToken = body.next_page_token || "";
+    moreEl.hidden = nextPageToken === "";
+  } catch (err) {
+    statusEl.textContent = `Failed to load ${current}: ${err}`;
+  }
+}
+
+function select(resource) {
+  current = resource;
+  nextPageToken = "";
+  detailEl.hidden = true;
+  bodyEl.replaceChildren();
+  for (const button of document.querySelectorAll("nav button")) {
+    button.classList.toggle("active", button.dataset.resource === resource);
+  }
+  renderHead();
+  load("");
+}
+
+for (const button of document.querySelectorAll("nav button")) {
+  button.addEventListener("click", () => select(button.dataset.resource));
+}
+moreEl.addEventListener("click", () => load(nextPageToken));
+
+select(current);
@@ -0,0 +1,31 @@
+package ui
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+)
+
+// Prefix is the path the UI is served under
+const Prefix = "/ui"
+
+//go:embed static
+var static embed.FS
+
+// Handler serves the embedded catalog browser under Prefix.
+func Handler() http.Handler {
+	assets, err := fs.Sub(static, "static")
+	if err != nil {
+		// The embedded directory is fixed at build time
+		panic(err)
+	}
+	fileServer := http.StripPrefix(Prefix, http.FileServer(http.FS(assets)))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == Prefix {
+			http.Redirect(w, r, Prefix+"/", http.StatusMovedPermanently)
+			return
+		}
+		fileServer.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,44 @@
+package ui_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/internal/ui"
+)
+
+var _ = Describe("UI Handler", func() {
+	var handler http.Handler
+
+	BeforeEach(func() {
+		handler = ui.Handler()
+	})
+
+	It("should serve the index page", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ui/", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Header().Get("Content-Type")).To(ContainSubstring("text/html"))
+		Expect(rec.Body.String()).To(ContainSubstring("DCM Catalog Manager"))
+	})
+
+	It("should serve static assets", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ui/app.js", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusOK))
+		Expect(rec.Body.String()).To(ContainSubstring("/api/v1alpha1"))
+	})
+
+	It("should redirect the bare prefix to the index", func() {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ui", nil))
+
+		Expect(rec.Code).To(Equal(http.StatusMovedPermanently))
+		Expect(rec.Header().Get("Location")).To(Equal("/ui/"))
+	})
+})
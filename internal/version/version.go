@@ -0,0 +1,20 @@
+package version
+
+import "runtime"
+
+// Build information, injected at build time via -ldflags "-X ...".
+var (
+	Version   = "dev"
+	GitCommit = "unknown"
+	BuildDate = "unknown"
+)
+
+// GoVersion is the Go runtime the binary was built with.
+func GoVersion() string {
+	return runtime.Version()
+}
+
+// Platform is the OS/architecture the binary was built for.
+func Platform() string {
+	return runtime.GOOS + "/" + runtime.GOARCH
+}
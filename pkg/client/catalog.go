@@ -0,0 +1,356 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+)
+
+// APIPrefix is where the catalog manager serves the API.
+const APIPrefix = "/api/v1alpha1/"
+
+// Catalog is a typed client of the catalog manager API, grouping the
+// operations by resource. Use ClientWithResponses for the other operations.
+type Catalog struct {
+	client *ClientWithResponses
+}
+
+type catalogOptions struct {
+	tlsConfig      *tls.Config
+	timeout        time.Duration
+	retry          *RetryPolicy
+	requestEditors []RequestEditorFn
+}
+
+// Option configures a Catalog.
+type Option func(*catalogOptions)
+
+// WithTLSConfig sets the TLS configuration of the connections, e.g. the CA
+// verifying the server and the client certificate authenticating to it.
+func WithTLSConfig(tlsConfig *tls.Config) Option {
+	return func(o *catalogOptions) {
+		o.tlsConfig = tlsConfig
+	}
+}
+
+// WithTimeout bounds each attempt of a request. It defaults to 30 seconds.
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *catalogOptions) {
+		o.timeout = timeout
+	}
+}
+
+// WithRetry overrides the default retry policy; a MaxAttempts of 1
+// disables retries.
+func WithRetry(policy RetryPolicy) Option {
+	return func(o *catalogOptions) {
+		o.retry = &policy
+	}
+}
+
+// WithRequestEditor adds a function editing every request, e.g. to set
+// authentication headers.
+func WithRequestEditor(fn RequestEditorFn) Option {
+	return func(o *catalogOptions) {
+		o.requestEditors = append(o.requestEditors, fn)
+	}
+}
+
+// New returns a client of the catalog manager at server, e.g.
+// https://catalog.example.com.
+func New(server string, opts ...Option) (*Catalog, error) {
+	options := catalogOptions{timeout: 30 * time.Second}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	policy := DefaultRetryPolicy
+	if options.retry != nil {
+		policy = *options.retry
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = options.tlsConfig
+	httpClient := &http.Client{
+		Transport: &retryTransport{next: transport, policy: policy, timeout: options.timeout},
+	}
+
+	clientOpts := []ClientOption{WithHTTPClient(httpClient)}
+	for _, fn := range options.requestEditors {
+		clientOpts = append(clientOpts, WithRequestEditorFn(fn))
+	}
+	client, err := NewClientWithResponses(strings.TrimSuffix(server, "/")+APIPrefix, clientOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Catalog{client: client}, nil
+}
+
+// ServiceTypes returns the operations on service types.
+func (c *Catalog) ServiceTypes() *ServiceTypes {
+	return &ServiceTypes{client: c.client}
+}
+
+// CatalogItems returns the operations on catalog items.
+func (c *Catalog) CatalogItems() *CatalogItems {
+	return &CatalogItems{client: c.client}
+}
+
+// Instances returns the operations on catalog item instances.
+func (c *Catalog) Instances() *Instances {
+	return &Instances{client: c.client}
+}
+
+// ServiceTypes are the operations on service types.
+type ServiceTypes struct {
+	client *ClientWithResponses
+}
+
+func (s *ServiceTypes) List(ctx context.Context, params *v1alpha1.ListServiceTypesParams) (*v1alpha1.ServiceTypeList, error) {
+	resp, err := s.client.ListServiceTypesWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (s *ServiceTypes) Get(ctx context.Context, id string) (*v1alpha1.ServiceType, error) {
+	resp, err := s.client.GetServiceTypeWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// Create creates serviceType with the given ID, or one generated by the
+// server when id is empty.
+func (s *ServiceTypes) Create(ctx context.Context, serviceType v1alpha1.ServiceType, id string) (*v1alpha1.ServiceType, error) {
+	resp, err := s.client.CreateServiceTypeWithResponse(ctx, &v1alpha1.CreateServiceTypeParams{Id: optional(id)}, serviceType)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON201, resp.HTTPResponse, resp.Body)
+}
+
+// CatalogItems are the operations on catalog items.
+type CatalogItems struct {
+	client *ClientWithResponses
+}
+
+func (s *CatalogItems) List(ctx context.Context, params *v1alpha1.ListCatalogItemsParams) (*v1alpha1.CatalogItemList, error) {
+	resp, err := s.client.ListCatalogItemsWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (s *CatalogItems) Get(ctx context.Context, id string) (*v1alpha1.CatalogItem, error) {
+	resp, err := s.client.GetCatalogItemWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// Create creates item with the given ID, or one generated by the server
+// when id is empty.
+func (s *CatalogItems) Create(ctx context.Context, item v1alpha1.CatalogItem, id string) (*v1alpha1.CatalogItem, error) {
+	resp, err := s.client.CreateCatalogItemWithResponse(ctx, &v1alpha1.CreateCatalogItemParams{Id: optional(id)}, item)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON201, resp.HTTPResponse, resp.Body)
+}
+
+// Update applies patch, a JSON merge patch (RFC 7396) such as a map of the
+// fields to change, to the catalog item.
+func (s *CatalogItems) Update(ctx context.Context, id string, patch any) (*v1alpha1.CatalogItem, error) {
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the patch: %w", err)
+	}
+	resp, err := s.client.UpdateCatalogItemWithBodyWithResponse(ctx, id, "application/merge-patch+json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (s *CatalogItems) Delete(ctx context.Context, id string) error {
+	resp, err := s.client.DeleteCatalogItemWithResponse(ctx, id, nil)
+	if err != nil {
+		return err
+	}
+	return checkStatus(resp.HTTPResponse, resp.Body)
+}
+
+// ForceDelete deletes a catalog item along with its instances, and returns
+// the number of instances deleted.
+func (s *CatalogItems) ForceDelete(ctx context.Context, id string) (int, error) {
+	force := true
+	resp, err := s.client.DeleteCatalogItemWithResponse(ctx, id, &v1alpha1.DeleteCatalogItemParams{Force: &force})
+	if err != nil {
+		return 0, err
+	}
+	if err := checkStatus(resp.HTTPResponse, resp.Body); err != nil {
+		return 0, err
+	}
+	deleted := resp.HTTPResponse.Header.Get("X-Deleted-Instances")
+	if deleted == "" {
+		return 0, nil
+	}
+	count, err := strconv.Atoi(deleted)
+	if err != nil {
+		return 0, fmt.Errorf("invalid X-Deleted-Instances header %q: %w", deleted, err)
+	}
+	return count, nil
+}
+
+// Instances are the operations on catalog item instances.
+type Instances struct {
+	client *ClientWithResponses
+}
+
+func (s *Instances) List(ctx context.Context, params *v1alpha1.ListCatalogItemInstancesParams) (*v1alpha1.CatalogItemInstanceList, error) {
+	resp, err := s.client.ListCatalogItemInstancesWithResponse(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (s *Instances) Get(ctx context.Context, id string) (*v1alpha1.CatalogItemInstance, error) {
+	resp, err := s.client.GetCatalogItemInstanceWithResponse(ctx, id, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// Describe returns the instance together with its catalog item and service
+// type.
+func (s *Instances) Describe(ctx context.Context, id string) (*v1alpha1.CatalogItemInstanceDescription, error) {
+	resp, err := s.client.DescribeCatalogItemInstanceWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+// Create creates instance with the given ID, or one generated by the server
+// when id is empty.
+func (s *Instances) Create(ctx context.Context, instance v1alpha1.CatalogItemInstance, id string) (*v1alpha1.CatalogItemInstance, error) {
+	resp, err := s.client.CreateCatalogItemInstanceWithResponse(ctx, &v1alpha1.CreateCatalogItemInstanceParams{Id: optional(id)}, instance)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON201, resp.HTTPResponse, resp.Body)
+}
+
+// Clone creates an instance of the same catalog item as the instance id,
+// with its user values and labels and the given overrides, under newID, or
+// an ID generated by the server when newID is empty.
+func (s *Instances) Clone(ctx context.Context, id string, overrides v1alpha1.CatalogItemInstanceCloneRequest, newID string) (*v1alpha1.CatalogItemInstance, error) {
+	resp, err := s.client.CloneCatalogItemInstanceWithResponse(ctx, id, &v1alpha1.CloneCatalogItemInstanceParams{Id: optional(newID)}, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON201, resp.HTTPResponse, resp.Body)
+}
+
+// Update applies patch, a JSON merge patch (RFC 7396) such as a map of the
+// fields to change, to the instance. Changes to fields that the catalog
+// item does not make editable are listed in the FieldViolations of the
+// APIError problem.
+func (s *Instances) Update(ctx context.Context, id string, patch any) (*v1alpha1.CatalogItemInstance, error) {
+	body, err := json.Marshal(patch)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode the patch: %w", err)
+	}
+	resp, err := s.client.UpdateCatalogItemInstanceWithBodyWithResponse(ctx, id, "application/merge-patch+json", bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	return result(resp.JSON200, resp.HTTPResponse, resp.Body)
+}
+
+func (s *Instances) Delete(ctx context.Context, id string) error {
+	resp, err := s.client.DeleteCatalogItemInstanceWithResponse(ctx, id)
+	if err != nil {
+		return err
+	}
+	return checkStatus(resp.HTTPResponse, resp.Body)
+}
+
+// APIError is a failed response of the API, with its problem details when
+// the server returned them.
+type APIError struct {
+	StatusCode int
+	Problem    *v1alpha1.Error
+}
+
+func (e *APIError) Error() string {
+	if e.Problem == nil {
+		return fmt.Sprintf("unexpected response: %d %s", e.StatusCode, http.StatusText(e.StatusCode))
+	}
+	if e.Problem.Detail != nil {
+		return fmt.Sprintf("%s: %s (%d)", e.Problem.Title, *e.Problem.Detail, e.StatusCode)
+	}
+	return fmt.Sprintf("%s (%d)", e.Problem.Title, e.StatusCode)
+}
+
+// IsNotFound reports whether err is an APIError for a missing resource.
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsAlreadyExists reports whether err is an APIError for a resource that
+// already exists.
+func IsAlreadyExists(err error) bool {
+	return hasStatus(err, http.StatusConflict)
+}
+
+func hasStatus(err error, status int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == status
+}
+
+// result returns value when the response was successful, and an APIError
+// otherwise.
+func result[T any](value *T, resp *http.Response, body []byte) (*T, error) {
+	if err := checkStatus(resp, body); err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, fmt.Errorf("unexpected response: %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	return value, nil
+}
+
+func checkStatus(resp *http.Response, body []byte) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	apiErr := &APIError{StatusCode: resp.StatusCode}
+	var problem v1alpha1.Error
+	if json.Unmarshal(body, &problem) == nil && problem.Title != "" {
+		apiErr.Problem = &problem
+	}
+	return apiErr
+}
+
+func optional(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
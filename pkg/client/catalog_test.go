@@ -0,0 +1,241 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/dcm-project/catalog-manager/api/v1alpha1"
+	"github.com/dcm-project/catalog-manager/pkg/client"
+)
+
+var _ = Describe("Catalog", func() {
+	var (
+		handler  http.HandlerFunc
+		attempts atomic.Int32
+		catalog  *client.Catalog
+	)
+
+	noBackoff := client.RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+	BeforeEach(func() {
+		attempts.Store(0)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			attempts.Add(1)
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		catalog, err = client.New(server.URL, client.WithRetry(noBackoff))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	writeJSON := func(w http.ResponseWriter, contentType string, status int, body any) {
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		Expect(json.NewEncoder(w).Encode(body)).To(Succeed())
+	}
+
+	problem := func(w http.ResponseWriter, status int, title, detail string) {
+		writeJSON(w, "application/problem+json", status, v1alpha1.Error{
+			Type: v1alpha1.NOTFOUND, Status: int32(status), Title: title, Detail: &detail,
+		})
+	}
+
+	It("should return the resource", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Path).To(Equal("/api/v1alpha1/catalog-items/small-vm"))
+			writeJSON(w, "application/json", http.StatusOK, v1alpha1.CatalogItem{ApiVersion: "v1alpha1", DisplayName: "Small VM"})
+		}
+
+		item, err := catalog.CatalogItems().Get(context.Background(), "small-vm")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(item.DisplayName).To(Equal("Small VM"))
+	})
+
+	It("should pass the ID of created resources", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Query().Get("id")).To(Equal("vm"))
+			writeJSON(w, "application/json", http.StatusCreated, v1alpha1.ServiceType{ServiceType: "vm"})
+		}
+
+		serviceType, err := catalog.ServiceTypes().Create(context.Background(), v1alpha1.ServiceType{ServiceType: "vm"}, "vm")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serviceType.ServiceType).To(Equal("vm"))
+	})
+
+	It("should send updates as JSON merge patches", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPatch))
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/merge-patch+json"))
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"display_name": "Large VM"}`))
+			writeJSON(w, "application/json", http.StatusOK, v1alpha1.CatalogItem{DisplayName: "Large VM"})
+		}
+
+		_, err := catalog.CatalogItems().Update(context.Background(), "vm", map[string]any{"display_name": "Large VM"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report the instances deleted along with a catalog item", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodDelete))
+			Expect(r.URL.Query().Get("force")).To(Equal("true"))
+			w.Header().Set("X-Deleted-Instances", "3")
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		deleted, err := catalog.CatalogItems().ForceDelete(context.Background(), "small-vm")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deleted).To(Equal(3))
+	})
+
+	It("should clone instances with overrides", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPost))
+			Expect(r.URL.Path).To(Equal("/api/v1alpha1/catalog-item-instances/vm-1:clone"))
+			Expect(r.URL.Query().Get("id")).To(Equal("vm-2"))
+			body, err := io.ReadAll(r.Body)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(body).To(MatchJSON(`{"display_name": "VM 2"}`))
+			writeJSON(w, "application/json", http.StatusCreated, v1alpha1.CatalogItemInstance{DisplayName: "VM 2"})
+		}
+
+		displayName := "VM 2"
+		instance, err := catalog.Instances().Clone(context.Background(), "vm-1",
+			v1alpha1.CatalogItemInstanceCloneRequest{DisplayName: &displayName}, "vm-2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.DisplayName).To(Equal("VM 2"))
+	})
+
+	It("should return problems as APIError", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			problem(w, http.StatusNotFound, "Resource not found", "no catalog item 'missing'")
+		}
+
+		_, err := catalog.CatalogItems().Get(context.Background(), "missing")
+		Expect(err).To(MatchError("Resource not found: no catalog item 'missing' (404)"))
+		Expect(client.IsNotFound(err)).To(BeTrue())
+		Expect(client.IsAlreadyExists(err)).To(BeFalse())
+	})
+
+	It("should expose the field violations of rejected updates", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.Method).To(Equal(http.MethodPatch))
+			Expect(r.URL.Path).To(Equal("/api/v1alpha1/catalog-item-instances/vm-1"))
+			detail := "Only editable fields can be changed"
+			writeJSON(w, "application/problem+json", http.StatusBadRequest, v1alpha1.Error{
+				Type: v1alpha1.INVALIDARGUMENT, Status: http.StatusBadRequest, Title: "Locked fields", Detail: &detail,
+				FieldViolations: &[]v1alpha1.FieldViolation{{Field: "spec.vcpu.count", Description: "Field is not editable"}},
+			})
+		}
+
+		_, err := catalog.Instances().Update(context.Background(), "vm-1", map[string]any{
+			"spec": map[string]any{"user_values": []any{map[string]any{"path": "spec.vcpu.count", "value": 8}}},
+		})
+		var apiErr *client.APIError
+		Expect(errors.As(err, &apiErr)).To(BeTrue())
+		Expect(apiErr.StatusCode).To(Equal(http.StatusBadRequest))
+		Expect(*apiErr.Problem.FieldViolations).To(ConsistOf(v1alpha1.FieldViolation{
+			Field: "spec.vcpu.count", Description: "Field is not editable",
+		}))
+	})
+
+	It("should retry idempotent requests while the server is unavailable", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Load() < 3 {
+				problem(w, http.StatusServiceUnavailable, "Service unavailable", "starting")
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}
+
+		Expect(catalog.Instances().Delete(context.Background(), "vm-1")).To(Succeed())
+		Expect(attempts.Load()).To(BeEquivalentTo(3))
+	})
+
+	It("should retry attempts that time out", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if attempts.Load() == 1 {
+				<-r.Context().Done()
+				return
+			}
+			writeJSON(w, "application/json", http.StatusOK, v1alpha1.CatalogItemInstance{DisplayName: "VM 1"})
+		}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			attempts.Add(1)
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+		catalog, err := client.New(server.URL, client.WithRetry(noBackoff), client.WithTimeout(50*time.Millisecond))
+		Expect(err).ToNot(HaveOccurred())
+
+		instance, err := catalog.Instances().Get(context.Background(), "vm-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(instance.DisplayName).To(Equal("VM 1"))
+		Expect(attempts.Load()).To(BeEquivalentTo(2))
+	})
+
+	It("should not retry once the caller's context is done", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			<-r.Context().Done()
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		_, err := catalog.Instances().Get(ctx, "vm-1")
+		Expect(err).To(MatchError(context.DeadlineExceeded))
+		Expect(attempts.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should give up after the maximum number of attempts", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			problem(w, http.StatusServiceUnavailable, "Service unavailable", "starting")
+		}
+
+		_, err := catalog.Instances().Get(context.Background(), "vm-1")
+		Expect(err).To(MatchError(ContainSubstring("(503)")))
+		Expect(attempts.Load()).To(BeEquivalentTo(3))
+	})
+
+	It("should not retry requests that are not idempotent", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			problem(w, http.StatusServiceUnavailable, "Service unavailable", "starting")
+		}
+
+		_, err := catalog.Instances().Create(context.Background(), v1alpha1.CatalogItemInstance{}, "")
+		Expect(err).To(HaveOccurred())
+		Expect(attempts.Load()).To(BeEquivalentTo(1))
+	})
+
+	It("should set headers with request editors", func() {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+			writeJSON(w, "application/json", http.StatusOK, v1alpha1.ServiceTypeList{Results: []v1alpha1.ServiceType{}})
+		}))
+		DeferCleanup(server.Close)
+
+		catalog, err := client.New(server.URL, client.WithRequestEditor(func(ctx context.Context, req *http.Request) error {
+			req.Header.Set("Authorization", "Bearer token")
+			return nil
+		}))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = catalog.ServiceTypes().List(context.Background(), nil)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
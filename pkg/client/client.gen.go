@@ -13,6 +13,8 @@ import (
 	"net/url"
 	"strings"
 
+	"gopkg.in/yaml.v2"
+
 	. "github.com/dcm-project/catalog-manager/api/v1alpha1"
 	"github.com/oapi-codegen/runtime"
 )
@@ -102,7 +104,25 @@ type ClientInterface interface {
 	DeleteCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCatalogItemInstance request
-	GetCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *GetCatalogItemInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCatalogItemInstanceWithBody request with any body
+	UpdateCatalogItemInstanceWithBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCatalogItemInstanceWithApplicationMergePatchPlusJSONBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// UpdateCatalogItemInstanceStatusWithBody request with any body
+	UpdateCatalogItemInstanceStatusWithBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	UpdateCatalogItemInstanceStatus(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CloneCatalogItemInstanceWithBody request with any body
+	CloneCatalogItemInstanceWithBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CloneCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, body CloneCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DescribeCatalogItemInstance request
+	DescribeCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListCatalogItems request
 	ListCatalogItems(ctx context.Context, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -113,18 +133,49 @@ type ClientInterface interface {
 	CreateCatalogItem(ctx context.Context, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeleteCatalogItem request
-	DeleteCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+	DeleteCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, params *DeleteCatalogItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetCatalogItem request
-	GetCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, params *GetCatalogItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// UpdateCatalogItemWithBody request with any body
 	UpdateCatalogItemWithBody(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	UpdateCatalogItemWithApplicationMergePatchPlusJSONBody(ctx context.Context, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCatalogItemIcon request
+	GetCatalogItemIcon(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetCatalogItemValidationBundle request
+	GetCatalogItemValidationBundle(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ArchiveCatalogItem request
+	ArchiveCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// EstimateCatalogItemWithBody request with any body
+	EstimateCatalogItemWithBody(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	EstimateCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, body EstimateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PreviewCatalogItemWithBody request with any body
+	PreviewCatalogItemWithBody(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PreviewCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, body PreviewCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PublishCatalogItem request
+	PublishCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ExportBackstageCatalogItems request
+	ExportBackstageCatalogItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// ListCatalogItemCategories request
+	ListCatalogItemCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetHealth request
-	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetHealth(ctx context.Context, params *GetHealthParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// Search request
+	Search(ctx context.Context, params *SearchParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// ListServiceTypes request
 	ListServiceTypes(ctx context.Context, params *ListServiceTypesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
@@ -135,7 +186,10 @@ type ClientInterface interface {
 	CreateServiceType(ctx context.Context, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// GetServiceType request
-	GetServiceType(ctx context.Context, serviceTypeId ServiceTypeIdPath, reqEditors ...RequestEditorFn) (*http.Response, error)
+	GetServiceType(ctx context.Context, serviceTypeId ServiceTypeIdPath, params *GetServiceTypeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetVersion request
+	GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) ListCatalogItemInstances(ctx context.Context, params *ListCatalogItemInstancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -186,8 +240,92 @@ func (c *Client) DeleteCatalogItemInstance(ctx context.Context, catalogItemInsta
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCatalogItemInstanceRequest(c.Server, catalogItemInstanceId)
+func (c *Client) GetCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *GetCatalogItemInstanceParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCatalogItemInstanceRequest(c.Server, catalogItemInstanceId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCatalogItemInstanceWithBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCatalogItemInstanceRequestWithBody(c.Server, catalogItemInstanceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCatalogItemInstanceWithApplicationMergePatchPlusJSONBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCatalogItemInstanceRequestWithApplicationMergePatchPlusJSONBody(c.Server, catalogItemInstanceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCatalogItemInstanceStatusWithBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCatalogItemInstanceStatusRequestWithBody(c.Server, catalogItemInstanceId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) UpdateCatalogItemInstanceStatus(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewUpdateCatalogItemInstanceStatusRequest(c.Server, catalogItemInstanceId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneCatalogItemInstanceWithBody(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneCatalogItemInstanceRequestWithBody(c.Server, catalogItemInstanceId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CloneCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, body CloneCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCloneCatalogItemInstanceRequest(c.Server, catalogItemInstanceId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DescribeCatalogItemInstance(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDescribeCatalogItemInstanceRequest(c.Server, catalogItemInstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -234,8 +372,8 @@ func (c *Client) CreateCatalogItem(ctx context.Context, params *CreateCatalogIte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeleteCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeleteCatalogItemRequest(c.Server, catalogItemId)
+func (c *Client) DeleteCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, params *DeleteCatalogItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteCatalogItemRequest(c.Server, catalogItemId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -246,8 +384,8 @@ func (c *Client) DeleteCatalogItem(ctx context.Context, catalogItemId CatalogIte
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetCatalogItemRequest(c.Server, catalogItemId)
+func (c *Client) GetCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, params *GetCatalogItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCatalogItemRequest(c.Server, catalogItemId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -282,8 +420,140 @@ func (c *Client) UpdateCatalogItemWithApplicationMergePatchPlusJSONBody(ctx cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetHealthRequest(c.Server)
+func (c *Client) GetCatalogItemIcon(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCatalogItemIconRequest(c.Server, catalogItemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetCatalogItemValidationBundle(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCatalogItemValidationBundleRequest(c.Server, catalogItemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ArchiveCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewArchiveCatalogItemRequest(c.Server, catalogItemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EstimateCatalogItemWithBody(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEstimateCatalogItemRequestWithBody(c.Server, catalogItemId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) EstimateCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, body EstimateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewEstimateCatalogItemRequest(c.Server, catalogItemId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewCatalogItemWithBody(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewCatalogItemRequestWithBody(c.Server, catalogItemId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PreviewCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, body PreviewCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPreviewCatalogItemRequest(c.Server, catalogItemId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PublishCatalogItem(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPublishCatalogItemRequest(c.Server, catalogItemId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ExportBackstageCatalogItems(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewExportBackstageCatalogItemsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) ListCatalogItemCategories(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewListCatalogItemCategoriesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, params *GetHealthParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) Search(ctx context.Context, params *SearchParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewSearchRequest(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -330,8 +600,20 @@ func (c *Client) CreateServiceType(ctx context.Context, params *CreateServiceTyp
 	return c.Client.Do(req)
 }
 
-func (c *Client) GetServiceType(ctx context.Context, serviceTypeId ServiceTypeIdPath, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewGetServiceTypeRequest(c.Server, serviceTypeId)
+func (c *Client) GetServiceType(ctx context.Context, serviceTypeId ServiceTypeIdPath, params *GetServiceTypeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetServiceTypeRequest(c.Server, serviceTypeId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetVersionRequest(c.Server)
 	if err != nil {
 		return nil, err
 	}
@@ -412,6 +694,54 @@ func NewListCatalogItemInstancesRequest(server string, params *ListCatalogItemIn
 
 		}
 
+		if params.Phase != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "phase", runtime.ParamLocationQuery, *params.Phase); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Owner != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "owner", runtime.ParamLocationQuery, *params.Owner); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -520,7 +850,7 @@ func NewDeleteCatalogItemInstanceRequest(server string, catalogItemInstanceId Ca
 }
 
 // NewGetCatalogItemInstanceRequest generates requests for GetCatalogItemInstance
-func NewGetCatalogItemInstanceRequest(server string, catalogItemInstanceId CatalogItemInstanceIdPath) (*http.Request, error) {
+func NewGetCatalogItemInstanceRequest(server string, catalogItemInstanceId CatalogItemInstanceIdPath, params *GetCatalogItemInstanceParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -545,71 +875,12 @@ func NewGetCatalogItemInstanceRequest(server string, catalogItemInstanceId Catal
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	return req, nil
-}
-
-// NewListCatalogItemsRequest generates requests for ListCatalogItems
-func NewListCatalogItemsRequest(server string, params *ListCatalogItemsParams) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/catalog-items")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.PageToken != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.MaxPageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_page_size", runtime.ParamLocationQuery, *params.MaxPageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ServiceType != nil {
+		if params.Fields != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service_type", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -631,78 +902,42 @@ func NewListCatalogItemsRequest(server string, params *ListCatalogItemsParams) (
 		return nil, err
 	}
 
-	return req, nil
-}
-
-// NewCreateCatalogItemRequest calls the generic CreateCatalogItem builder with application/json body
-func NewCreateCatalogItemRequest(server string, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewCreateCatalogItemRequestWithBody(server, params, "application/json", bodyReader)
-}
-
-// NewCreateCatalogItemRequestWithBody generates requests for CreateCatalogItem with any type of body
-func NewCreateCatalogItemRequestWithBody(server string, params *CreateCatalogItemParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/catalog-items")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
 	if params != nil {
-		queryValues := queryURL.Query()
 
-		if params.Id != nil {
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
 				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
 			}
 
+			req.Header.Set("If-None-Match", headerParam0)
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	return req, nil
+}
+
+// NewUpdateCatalogItemInstanceRequestWithApplicationMergePatchPlusJSONBody calls the generic UpdateCatalogItemInstance builder with application/merge-patch+json body
+func NewUpdateCatalogItemInstanceRequestWithApplicationMergePatchPlusJSONBody(server string, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCatalogItemInstanceRequestWithBody(server, catalogItemInstanceId, "application/merge-patch+json", bodyReader)
 }
 
-// NewDeleteCatalogItemRequest generates requests for DeleteCatalogItem
-func NewDeleteCatalogItemRequest(server string, catalogItemId CatalogItemIdPath) (*http.Request, error) {
+// NewUpdateCatalogItemInstanceRequestWithBody generates requests for UpdateCatalogItemInstance with any type of body
+func NewUpdateCatalogItemInstanceRequestWithBody(server string, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemInstanceId", runtime.ParamLocationPath, catalogItemInstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -712,7 +947,7 @@ func NewDeleteCatalogItemRequest(server string, catalogItemId CatalogItemIdPath)
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog-items/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog-item-instances/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -722,21 +957,34 @@ func NewDeleteCatalogItemRequest(server string, catalogItemId CatalogItemIdPath)
 		return nil, err
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewGetCatalogItemRequest generates requests for GetCatalogItem
-func NewGetCatalogItemRequest(server string, catalogItemId CatalogItemIdPath) (*http.Request, error) {
+// NewUpdateCatalogItemInstanceStatusRequest calls the generic UpdateCatalogItemInstanceStatus builder with application/json body
+func NewUpdateCatalogItemInstanceStatusRequest(server string, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCatalogItemInstanceStatusRequestWithBody(server, catalogItemInstanceId, "application/json", bodyReader)
+}
+
+// NewUpdateCatalogItemInstanceStatusRequestWithBody generates requests for UpdateCatalogItemInstanceStatus with any type of body
+func NewUpdateCatalogItemInstanceStatusRequestWithBody(server string, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemInstanceId", runtime.ParamLocationPath, catalogItemInstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -746,7 +994,7 @@ func NewGetCatalogItemRequest(server string, catalogItemId CatalogItemIdPath) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog-items/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog-item-instances/%s/status", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -756,32 +1004,34 @@ func NewGetCatalogItemRequest(server string, catalogItemId CatalogItemIdPath) (*
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewUpdateCatalogItemRequestWithApplicationMergePatchPlusJSONBody calls the generic UpdateCatalogItem builder with application/merge-patch+json body
-func NewUpdateCatalogItemRequestWithApplicationMergePatchPlusJSONBody(server string, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+// NewCloneCatalogItemInstanceRequest calls the generic CloneCatalogItemInstance builder with application/json body
+func NewCloneCatalogItemInstanceRequest(server string, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, body CloneCatalogItemInstanceJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewUpdateCatalogItemRequestWithBody(server, catalogItemId, "application/merge-patch+json", bodyReader)
+	return NewCloneCatalogItemInstanceRequestWithBody(server, catalogItemInstanceId, params, "application/json", bodyReader)
 }
 
-// NewUpdateCatalogItemRequestWithBody generates requests for UpdateCatalogItem with any type of body
-func NewUpdateCatalogItemRequestWithBody(server string, catalogItemId CatalogItemIdPath, contentType string, body io.Reader) (*http.Request, error) {
+// NewCloneCatalogItemInstanceRequestWithBody generates requests for CloneCatalogItemInstance with any type of body
+func NewCloneCatalogItemInstanceRequestWithBody(server string, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemInstanceId", runtime.ParamLocationPath, catalogItemInstanceId)
 	if err != nil {
 		return nil, err
 	}
@@ -791,7 +1041,7 @@ func NewUpdateCatalogItemRequestWithBody(server string, catalogItemId CatalogIte
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/catalog-items/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog-item-instances/%s:clone", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -801,7 +1051,29 @@ func NewUpdateCatalogItemRequestWithBody(server string, catalogItemId CatalogIte
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -811,16 +1083,23 @@ func NewUpdateCatalogItemRequestWithBody(server string, catalogItemId CatalogIte
 	return req, nil
 }
 
-// NewGetHealthRequest generates requests for GetHealth
-func NewGetHealthRequest(server string) (*http.Request, error) {
+// NewDescribeCatalogItemInstanceRequest generates requests for DescribeCatalogItemInstance
+func NewDescribeCatalogItemInstanceRequest(server string, catalogItemInstanceId CatalogItemInstanceIdPath) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemInstanceId", runtime.ParamLocationPath, catalogItemInstanceId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/health")
+	operationPath := fmt.Sprintf("/catalog-item-instances/%s:describe", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -838,8 +1117,8 @@ func NewGetHealthRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewListServiceTypesRequest generates requests for ListServiceTypes
-func NewListServiceTypesRequest(server string, params *ListServiceTypesParams) (*http.Request, error) {
+// NewListCatalogItemsRequest generates requests for ListCatalogItems
+func NewListCatalogItemsRequest(server string, params *ListCatalogItemsParams) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -847,7 +1126,7 @@ func NewListServiceTypesRequest(server string, params *ListServiceTypesParams) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/service-types")
+	operationPath := fmt.Sprintf("/catalog-items")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -892,6 +1171,70 @@ func NewListServiceTypesRequest(server string, params *ListServiceTypesParams) (
 
 		}
 
+		if params.ServiceType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "service_type", runtime.ParamLocationQuery, *params.ServiceType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Category != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "category", runtime.ParamLocationQuery, *params.Category); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ShowArchived != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "show_archived", runtime.ParamLocationQuery, *params.ShowArchived); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
@@ -903,19 +1246,19 @@ func NewListServiceTypesRequest(server string, params *ListServiceTypesParams) (
 	return req, nil
 }
 
-// NewCreateServiceTypeRequest calls the generic CreateServiceType builder with application/json body
-func NewCreateServiceTypeRequest(server string, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody) (*http.Request, error) {
+// NewCreateCatalogItemRequest calls the generic CreateCatalogItem builder with application/json body
+func NewCreateCatalogItemRequest(server string, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewCreateServiceTypeRequestWithBody(server, params, "application/json", bodyReader)
+	return NewCreateCatalogItemRequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewCreateServiceTypeRequestWithBody generates requests for CreateServiceType with any type of body
-func NewCreateServiceTypeRequestWithBody(server string, params *CreateServiceTypeParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewCreateCatalogItemRequestWithBody generates requests for CreateCatalogItem with any type of body
+func NewCreateCatalogItemRequestWithBody(server string, params *CreateCatalogItemParams, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -923,7 +1266,7 @@ func NewCreateServiceTypeRequestWithBody(server string, params *CreateServiceTyp
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/service-types")
+	operationPath := fmt.Sprintf("/catalog-items")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -965,13 +1308,13 @@ func NewCreateServiceTypeRequestWithBody(server string, params *CreateServiceTyp
 	return req, nil
 }
 
-// NewGetServiceTypeRequest generates requests for GetServiceType
-func NewGetServiceTypeRequest(server string, serviceTypeId ServiceTypeIdPath) (*http.Request, error) {
+// NewDeleteCatalogItemRequest generates requests for DeleteCatalogItem
+func NewDeleteCatalogItemRequest(server string, catalogItemId CatalogItemIdPath, params *DeleteCatalogItemParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceTypeId", runtime.ParamLocationPath, serviceTypeId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
 	if err != nil {
 		return nil, err
 	}
@@ -981,7 +1324,7 @@ func NewGetServiceTypeRequest(server string, serviceTypeId ServiceTypeIdPath) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/service-types/%s", pathParam0)
+	operationPath := fmt.Sprintf("/catalog-items/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -991,7 +1334,29 @@ func NewGetServiceTypeRequest(server string, serviceTypeId ServiceTypeIdPath) (*
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Force != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "force", runtime.ParamLocationQuery, *params.Force); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -999,596 +1364,2581 @@ func NewGetServiceTypeRequest(server string, serviceTypeId ServiceTypeIdPath) (*
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetCatalogItemRequest generates requests for GetCatalogItem
+func NewGetCatalogItemRequest(server string, catalogItemId CatalogItemIdPath, params *GetCatalogItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	operationPath := fmt.Sprintf("/catalog-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
 		}
-		c.Server = newBaseURL.String()
-		return nil
+
+		queryURL.RawQuery = queryValues.Encode()
 	}
-}
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// ListCatalogItemInstancesWithResponse request
-	ListCatalogItemInstancesWithResponse(ctx context.Context, params *ListCatalogItemInstancesParams, reqEditors ...RequestEditorFn) (*ListCatalogItemInstancesResponse, error)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateCatalogItemInstanceWithBodyWithResponse request with any body
-	CreateCatalogItemInstanceWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error)
+	if params != nil {
 
-	CreateCatalogItemInstanceWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, body CreateCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error)
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
 
-	// DeleteCatalogItemInstanceWithResponse request
-	DeleteCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*DeleteCatalogItemInstanceResponse, error)
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
 
-	// GetCatalogItemInstanceWithResponse request
-	GetCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemInstanceResponse, error)
+			req.Header.Set("If-None-Match", headerParam0)
+		}
 
-	// ListCatalogItemsWithResponse request
-	ListCatalogItemsWithResponse(ctx context.Context, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error)
+	}
 
-	// CreateCatalogItemWithBodyWithResponse request with any body
-	CreateCatalogItemWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+	return req, nil
+}
 
-	CreateCatalogItemWithResponse(ctx context.Context, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+// NewUpdateCatalogItemRequestWithApplicationMergePatchPlusJSONBody calls the generic UpdateCatalogItem builder with application/merge-patch+json body
+func NewUpdateCatalogItemRequestWithApplicationMergePatchPlusJSONBody(server string, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewUpdateCatalogItemRequestWithBody(server, catalogItemId, "application/merge-patch+json", bodyReader)
+}
 
-	// DeleteCatalogItemWithResponse request
-	DeleteCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error)
+// NewUpdateCatalogItemRequestWithBody generates requests for UpdateCatalogItem with any type of body
+func NewUpdateCatalogItemRequestWithBody(server string, catalogItemId CatalogItemIdPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetCatalogItemWithResponse request
-	GetCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error)
+	var pathParam0 string
 
-	// UpdateCatalogItemWithBodyWithResponse request with any body
-	UpdateCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateCatalogItemWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetHealthWithResponse request
-	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+	operationPath := fmt.Sprintf("/catalog-items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// ListServiceTypesWithResponse request
-	ListServiceTypesWithResponse(ctx context.Context, params *ListServiceTypesParams, reqEditors ...RequestEditorFn) (*ListServiceTypesResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateServiceTypeWithBodyWithResponse request with any body
-	CreateServiceTypeWithBodyWithResponse(ctx context.Context, params *CreateServiceTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error)
+	req, err := http.NewRequest("PATCH", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	CreateServiceTypeWithResponse(ctx context.Context, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	// GetServiceTypeWithResponse request
-	GetServiceTypeWithResponse(ctx context.Context, serviceTypeId ServiceTypeIdPath, reqEditors ...RequestEditorFn) (*GetServiceTypeResponse, error)
+	return req, nil
 }
 
-type ListCatalogItemInstancesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItemInstanceList
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+// NewGetCatalogItemIconRequest generates requests for GetCatalogItemIcon
+func NewGetCatalogItemIconRequest(server string, catalogItemId CatalogItemIdPath) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListCatalogItemInstancesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCatalogItemInstancesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateCatalogItemInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CatalogItemInstance
-	JSON400      *Error
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON409      *AlreadyExists
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/catalog-items/%s/icon", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateCatalogItemInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCatalogItemInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCatalogItemInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCatalogItemInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewGetCatalogItemValidationBundleRequest generates requests for GetCatalogItemValidationBundle
+func NewGetCatalogItemValidationBundleRequest(server string, catalogItemId CatalogItemIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCatalogItemInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetCatalogItemInstanceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItemInstance
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/catalog-items/%s/validation-bundle", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r GetCatalogItemInstanceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogItemInstanceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type ListCatalogItemsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItemList
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r ListCatalogItemsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewArchiveCatalogItemRequest generates requests for ArchiveCatalogItem
+func NewArchiveCatalogItemRequest(server string, catalogItemId CatalogItemIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListCatalogItemsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateCatalogItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *CatalogItem
-	JSON400      *Error
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON409      *AlreadyExists
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/catalog-items/%s:archive", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateCatalogItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateCatalogItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type DeleteCatalogItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeleteCatalogItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewEstimateCatalogItemRequest calls the generic EstimateCatalogItem builder with application/json body
+func NewEstimateCatalogItemRequest(server string, catalogItemId CatalogItemIdPath, body EstimateCatalogItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
+	bodyReader = bytes.NewReader(buf)
+	return NewEstimateCatalogItemRequestWithBody(server, catalogItemId, "application/json", bodyReader)
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeleteCatalogItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
-}
+// NewEstimateCatalogItemRequestWithBody generates requests for EstimateCatalogItem with any type of body
+func NewEstimateCatalogItemRequestWithBody(server string, catalogItemId CatalogItemIdPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-type GetCatalogItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItem
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+	var pathParam0 string
 
-// Status returns HTTPResponse.Status
-func (r GetCatalogItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetCatalogItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type UpdateCatalogItemResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *CatalogItem
-	JSON400      *Error
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-	JSON500      *InternalServerError
-}
+	operationPath := fmt.Sprintf("/catalog-items/%s:estimate", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-// Status returns HTTPResponse.Status
-func (r UpdateCatalogItemResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r UpdateCatalogItemResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetHealthResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *Health
-}
+	req.Header.Add("Content-Type", contentType)
 
-// Status returns HTTPResponse.Status
-func (r GetHealthResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
+	return req, nil
 }
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetHealthResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+// NewPreviewCatalogItemRequest calls the generic PreviewCatalogItem builder with application/json body
+func NewPreviewCatalogItemRequest(server string, catalogItemId CatalogItemIdPath, body PreviewCatalogItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
-	return 0
+	bodyReader = bytes.NewReader(buf)
+	return NewPreviewCatalogItemRequestWithBody(server, catalogItemId, "application/json", bodyReader)
 }
 
-type ListServiceTypesResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceTypeList
-	JSON400      *BadRequest
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON500      *InternalServerError
-}
+// NewPreviewCatalogItemRequestWithBody generates requests for PreviewCatalogItem with any type of body
+func NewPreviewCatalogItemRequestWithBody(server string, catalogItemId CatalogItemIdPath, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-// Status returns HTTPResponse.Status
-func (r ListServiceTypesResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	var pathParam0 string
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r ListServiceTypesResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type CreateServiceTypeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON201      *ServiceType
-	JSON400      *Error
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON409      *AlreadyExists
-	JSON500      *InternalServerError
-}
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r CreateServiceTypeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	operationPath := fmt.Sprintf("/catalog-items/%s:preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r CreateServiceTypeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-type GetServiceTypeResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *ServiceType
-	JSON401      *Unauthorized
-	JSON403      *Forbidden
-	JSON404      *NotFound
-	JSON500      *InternalServerError
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r GetServiceTypeResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// NewPublishCatalogItemRequest generates requests for PublishCatalogItem
+func NewPublishCatalogItemRequest(server string, catalogItemId CatalogItemIdPath) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "catalogItemId", runtime.ParamLocationPath, catalogItemId)
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r GetServiceTypeResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	return 0
-}
 
-// ListCatalogItemInstancesWithResponse request returning *ListCatalogItemInstancesResponse
-func (c *ClientWithResponses) ListCatalogItemInstancesWithResponse(ctx context.Context, params *ListCatalogItemInstancesParams, reqEditors ...RequestEditorFn) (*ListCatalogItemInstancesResponse, error) {
-	rsp, err := c.ListCatalogItemInstances(ctx, params, reqEditors...)
+	operationPath := fmt.Sprintf("/catalog-items/%s:publish", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCatalogItemInstancesResponse(rsp)
-}
 
-// CreateCatalogItemInstanceWithBodyWithResponse request with arbitrary body returning *CreateCatalogItemInstanceResponse
-func (c *ClientWithResponses) CreateCatalogItemInstanceWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error) {
-	rsp, err := c.CreateCatalogItemInstanceWithBody(ctx, params, contentType, body, reqEditors...)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCatalogItemInstanceResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) CreateCatalogItemInstanceWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, body CreateCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error) {
-	rsp, err := c.CreateCatalogItemInstance(ctx, params, body, reqEditors...)
+// NewExportBackstageCatalogItemsRequest generates requests for ExportBackstageCatalogItems
+func NewExportBackstageCatalogItemsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCatalogItemInstanceResponse(rsp)
-}
 
-// DeleteCatalogItemInstanceWithResponse request returning *DeleteCatalogItemInstanceResponse
-func (c *ClientWithResponses) DeleteCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*DeleteCatalogItemInstanceResponse, error) {
-	rsp, err := c.DeleteCatalogItemInstance(ctx, catalogItemInstanceId, reqEditors...)
+	operationPath := fmt.Sprintf("/catalog-items:exportBackstage")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteCatalogItemInstanceResponse(rsp)
-}
 
-// GetCatalogItemInstanceWithResponse request returning *GetCatalogItemInstanceResponse
-func (c *ClientWithResponses) GetCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemInstanceResponse, error) {
-	rsp, err := c.GetCatalogItemInstance(ctx, catalogItemInstanceId, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCatalogItemInstanceResponse(rsp)
+
+	return req, nil
 }
 
-// ListCatalogItemsWithResponse request returning *ListCatalogItemsResponse
-func (c *ClientWithResponses) ListCatalogItemsWithResponse(ctx context.Context, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error) {
-	rsp, err := c.ListCatalogItems(ctx, params, reqEditors...)
+// NewListCatalogItemCategoriesRequest generates requests for ListCatalogItemCategories
+func NewListCatalogItemCategoriesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListCatalogItemsResponse(rsp)
-}
 
-// CreateCatalogItemWithBodyWithResponse request with arbitrary body returning *CreateCatalogItemResponse
-func (c *ClientWithResponses) CreateCatalogItemWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
-	rsp, err := c.CreateCatalogItemWithBody(ctx, params, contentType, body, reqEditors...)
+	operationPath := fmt.Sprintf("/catalog-items:listCategories")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCatalogItemResponse(rsp)
-}
 
-func (c *ClientWithResponses) CreateCatalogItemWithResponse(ctx context.Context, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
-	rsp, err := c.CreateCatalogItem(ctx, params, body, reqEditors...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseCreateCatalogItemResponse(rsp)
+
+	return req, nil
 }
 
-// DeleteCatalogItemWithResponse request returning *DeleteCatalogItemResponse
-func (c *ClientWithResponses) DeleteCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error) {
-	rsp, err := c.DeleteCatalogItem(ctx, catalogItemId, reqEditors...)
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string, params *GetHealthParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeleteCatalogItemResponse(rsp)
-}
 
-// GetCatalogItemWithResponse request returning *GetCatalogItemResponse
-func (c *ClientWithResponses) GetCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error) {
-	rsp, err := c.GetCatalogItem(ctx, catalogItemId, reqEditors...)
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetCatalogItemResponse(rsp)
-}
 
-// UpdateCatalogItemWithBodyWithResponse request with arbitrary body returning *UpdateCatalogItemResponse
-func (c *ClientWithResponses) UpdateCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error) {
-	rsp, err := c.UpdateCatalogItemWithBody(ctx, catalogItemId, contentType, body, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.View != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "view", runtime.ParamLocationQuery, *params.View); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCatalogItemResponse(rsp)
+
+	return req, nil
 }
 
-func (c *ClientWithResponses) UpdateCatalogItemWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error) {
-	rsp, err := c.UpdateCatalogItemWithApplicationMergePatchPlusJSONBody(ctx, catalogItemId, body, reqEditors...)
+// NewSearchRequest generates requests for Search
+func NewSearchRequest(server string, params *SearchParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
-	return ParseUpdateCatalogItemResponse(rsp)
-}
 
-// GetHealthWithResponse request returning *GetHealthResponse
-func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
-	rsp, err := c.GetHealth(ctx, reqEditors...)
+	operationPath := fmt.Sprintf("/search")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
 	if err != nil {
 		return nil, err
 	}
-	return ParseGetHealthResponse(rsp)
-}
 
-// ListServiceTypesWithResponse request returning *ListServiceTypesResponse
-func (c *ClientWithResponses) ListServiceTypesWithResponse(ctx context.Context, params *ListServiceTypesParams, reqEditors ...RequestEditorFn) (*ListServiceTypesResponse, error) {
-	rsp, err := c.ListServiceTypes(ctx, params, reqEditors...)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "q", runtime.ParamLocationQuery, params.Q); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_page_size", runtime.ParamLocationQuery, *params.MaxPageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return ParseListServiceTypesResponse(rsp)
+
+	return req, nil
 }
 
-// CreateServiceTypeWithBodyWithResponse request with arbitrary body returning *CreateServiceTypeResponse
-func (c *ClientWithResponses) CreateServiceTypeWithBodyWithResponse(ctx context.Context, params *CreateServiceTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error) {
-	rsp, err := c.CreateServiceTypeWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseCreateServiceTypeResponse(rsp)
-}
+// NewListServiceTypesRequest generates requests for ListServiceTypes
+func NewListServiceTypesRequest(server string, params *ListServiceTypesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/service-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PageToken != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "page_token", runtime.ParamLocationQuery, *params.PageToken); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxPageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_page_size", runtime.ParamLocationQuery, *params.MaxPageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ExcludeDeprecated != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "exclude_deprecated", runtime.ParamLocationQuery, *params.ExcludeDeprecated); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewCreateServiceTypeRequest calls the generic CreateServiceType builder with application/json body
+func NewCreateServiceTypeRequest(server string, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateServiceTypeRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewCreateServiceTypeRequestWithBody generates requests for CreateServiceType with any type of body
+func NewCreateServiceTypeRequestWithBody(server string, params *CreateServiceTypeParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/service-types")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Id != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "id", runtime.ParamLocationQuery, *params.Id); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetServiceTypeRequest generates requests for GetServiceType
+func NewGetServiceTypeRequest(server string, serviceTypeId ServiceTypeIdPath, params *GetServiceTypeParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serviceTypeId", runtime.ParamLocationPath, serviceTypeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/service-types/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Fields != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", false, "fields", runtime.ParamLocationQuery, *params.Fields); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfNoneMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-None-Match", runtime.ParamLocationHeader, *params.IfNoneMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-None-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetVersionRequest generates requests for GetVersion
+func NewGetVersionRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/version")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// ListCatalogItemInstancesWithResponse request
+	ListCatalogItemInstancesWithResponse(ctx context.Context, params *ListCatalogItemInstancesParams, reqEditors ...RequestEditorFn) (*ListCatalogItemInstancesResponse, error)
+
+	// CreateCatalogItemInstanceWithBodyWithResponse request with any body
+	CreateCatalogItemInstanceWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error)
+
+	CreateCatalogItemInstanceWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, body CreateCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error)
+
+	// DeleteCatalogItemInstanceWithResponse request
+	DeleteCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*DeleteCatalogItemInstanceResponse, error)
+
+	// GetCatalogItemInstanceWithResponse request
+	GetCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *GetCatalogItemInstanceParams, reqEditors ...RequestEditorFn) (*GetCatalogItemInstanceResponse, error)
+
+	// UpdateCatalogItemInstanceWithBodyWithResponse request with any body
+	UpdateCatalogItemInstanceWithBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceResponse, error)
+
+	UpdateCatalogItemInstanceWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceResponse, error)
+
+	// UpdateCatalogItemInstanceStatusWithBodyWithResponse request with any body
+	UpdateCatalogItemInstanceStatusWithBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceStatusResponse, error)
+
+	UpdateCatalogItemInstanceStatusWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceStatusResponse, error)
+
+	// CloneCatalogItemInstanceWithBodyWithResponse request with any body
+	CloneCatalogItemInstanceWithBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneCatalogItemInstanceResponse, error)
+
+	CloneCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, body CloneCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneCatalogItemInstanceResponse, error)
+
+	// DescribeCatalogItemInstanceWithResponse request
+	DescribeCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*DescribeCatalogItemInstanceResponse, error)
+
+	// ListCatalogItemsWithResponse request
+	ListCatalogItemsWithResponse(ctx context.Context, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error)
+
+	// CreateCatalogItemWithBodyWithResponse request with any body
+	CreateCatalogItemWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+
+	CreateCatalogItemWithResponse(ctx context.Context, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error)
+
+	// DeleteCatalogItemWithResponse request
+	DeleteCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, params *DeleteCatalogItemParams, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error)
+
+	// GetCatalogItemWithResponse request
+	GetCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, params *GetCatalogItemParams, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error)
+
+	// UpdateCatalogItemWithBodyWithResponse request with any body
+	UpdateCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error)
+
+	UpdateCatalogItemWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error)
+
+	// GetCatalogItemIconWithResponse request
+	GetCatalogItemIconWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemIconResponse, error)
+
+	// GetCatalogItemValidationBundleWithResponse request
+	GetCatalogItemValidationBundleWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemValidationBundleResponse, error)
+
+	// ArchiveCatalogItemWithResponse request
+	ArchiveCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*ArchiveCatalogItemResponse, error)
+
+	// EstimateCatalogItemWithBodyWithResponse request with any body
+	EstimateCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EstimateCatalogItemResponse, error)
+
+	EstimateCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body EstimateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*EstimateCatalogItemResponse, error)
+
+	// PreviewCatalogItemWithBodyWithResponse request with any body
+	PreviewCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewCatalogItemResponse, error)
+
+	PreviewCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body PreviewCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewCatalogItemResponse, error)
+
+	// PublishCatalogItemWithResponse request
+	PublishCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*PublishCatalogItemResponse, error)
+
+	// ExportBackstageCatalogItemsWithResponse request
+	ExportBackstageCatalogItemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportBackstageCatalogItemsResponse, error)
+
+	// ListCatalogItemCategoriesWithResponse request
+	ListCatalogItemCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCatalogItemCategoriesResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, params *GetHealthParams, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// SearchWithResponse request
+	SearchWithResponse(ctx context.Context, params *SearchParams, reqEditors ...RequestEditorFn) (*SearchResponse, error)
+
+	// ListServiceTypesWithResponse request
+	ListServiceTypesWithResponse(ctx context.Context, params *ListServiceTypesParams, reqEditors ...RequestEditorFn) (*ListServiceTypesResponse, error)
+
+	// CreateServiceTypeWithBodyWithResponse request with any body
+	CreateServiceTypeWithBodyWithResponse(ctx context.Context, params *CreateServiceTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error)
+
+	CreateServiceTypeWithResponse(ctx context.Context, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error)
+
+	// GetServiceTypeWithResponse request
+	GetServiceTypeWithResponse(ctx context.Context, serviceTypeId ServiceTypeIdPath, params *GetServiceTypeParams, reqEditors ...RequestEditorFn) (*GetServiceTypeResponse, error)
+
+	// GetVersionWithResponse request
+	GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error)
+}
+
+type ListCatalogItemInstancesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemInstanceList
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCatalogItemInstancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCatalogItemInstancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCatalogItemInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CatalogItemInstance
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *AlreadyExists
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCatalogItemInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCatalogItemInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCatalogItemInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCatalogItemInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCatalogItemInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogItemInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemInstance
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogItemInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogItemInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCatalogItemInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemInstance
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCatalogItemInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCatalogItemInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCatalogItemInstanceStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemInstance
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCatalogItemInstanceStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCatalogItemInstanceStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CloneCatalogItemInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CatalogItemInstance
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *AlreadyExists
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CloneCatalogItemInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CloneCatalogItemInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DescribeCatalogItemInstanceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemInstanceDescription
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DescribeCatalogItemInstanceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DescribeCatalogItemInstanceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCatalogItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemList
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCatalogItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCatalogItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *CatalogItem
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *AlreadyExists
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Error
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type UpdateCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r UpdateCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r UpdateCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogItemIconResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *Error
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogItemIconResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogItemIconResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetCatalogItemValidationBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ValidationBundle
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCatalogItemValidationBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCatalogItemValidationBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ArchiveCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Error
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ArchiveCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ArchiveCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type EstimateCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CostEstimate
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Error
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r EstimateCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r EstimateCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PreviewCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItemPreview
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PreviewCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PreviewCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PublishCatalogItemResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CatalogItem
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON409      *Error
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r PublishCatalogItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PublishCatalogItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ExportBackstageCatalogItemsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	YAML200      *string
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ExportBackstageCatalogItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ExportBackstageCatalogItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListCatalogItemCategoriesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *CategoryList
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListCatalogItemCategoriesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListCatalogItemCategoriesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Health
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type SearchResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SearchResultList
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r SearchResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r SearchResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type ListServiceTypesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceTypeList
+	JSON400      *BadRequest
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r ListServiceTypesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r ListServiceTypesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateServiceTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ServiceType
+	JSON400      *Error
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON409      *AlreadyExists
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateServiceTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateServiceTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetServiceTypeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ServiceType
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *NotFound
+	JSON500      *InternalServerError
+}
+
+// Status returns HTTPResponse.Status
+func (r GetServiceTypeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetServiceTypeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Version
+}
+
+// Status returns HTTPResponse.Status
+func (r GetVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// ListCatalogItemInstancesWithResponse request returning *ListCatalogItemInstancesResponse
+func (c *ClientWithResponses) ListCatalogItemInstancesWithResponse(ctx context.Context, params *ListCatalogItemInstancesParams, reqEditors ...RequestEditorFn) (*ListCatalogItemInstancesResponse, error) {
+	rsp, err := c.ListCatalogItemInstances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogItemInstancesResponse(rsp)
+}
+
+// CreateCatalogItemInstanceWithBodyWithResponse request with arbitrary body returning *CreateCatalogItemInstanceResponse
+func (c *ClientWithResponses) CreateCatalogItemInstanceWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error) {
+	rsp, err := c.CreateCatalogItemInstanceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogItemInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCatalogItemInstanceWithResponse(ctx context.Context, params *CreateCatalogItemInstanceParams, body CreateCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemInstanceResponse, error) {
+	rsp, err := c.CreateCatalogItemInstance(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogItemInstanceResponse(rsp)
+}
+
+// DeleteCatalogItemInstanceWithResponse request returning *DeleteCatalogItemInstanceResponse
+func (c *ClientWithResponses) DeleteCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*DeleteCatalogItemInstanceResponse, error) {
+	rsp, err := c.DeleteCatalogItemInstance(ctx, catalogItemInstanceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCatalogItemInstanceResponse(rsp)
+}
+
+// GetCatalogItemInstanceWithResponse request returning *GetCatalogItemInstanceResponse
+func (c *ClientWithResponses) GetCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *GetCatalogItemInstanceParams, reqEditors ...RequestEditorFn) (*GetCatalogItemInstanceResponse, error) {
+	rsp, err := c.GetCatalogItemInstance(ctx, catalogItemInstanceId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogItemInstanceResponse(rsp)
+}
+
+// UpdateCatalogItemInstanceWithBodyWithResponse request with arbitrary body returning *UpdateCatalogItemInstanceResponse
+func (c *ClientWithResponses) UpdateCatalogItemInstanceWithBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceResponse, error) {
+	rsp, err := c.UpdateCatalogItemInstanceWithBody(ctx, catalogItemInstanceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCatalogItemInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCatalogItemInstanceWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceResponse, error) {
+	rsp, err := c.UpdateCatalogItemInstanceWithApplicationMergePatchPlusJSONBody(ctx, catalogItemInstanceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCatalogItemInstanceResponse(rsp)
+}
+
+// UpdateCatalogItemInstanceStatusWithBodyWithResponse request with arbitrary body returning *UpdateCatalogItemInstanceStatusResponse
+func (c *ClientWithResponses) UpdateCatalogItemInstanceStatusWithBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceStatusResponse, error) {
+	rsp, err := c.UpdateCatalogItemInstanceStatusWithBody(ctx, catalogItemInstanceId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCatalogItemInstanceStatusResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCatalogItemInstanceStatusWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, body UpdateCatalogItemInstanceStatusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemInstanceStatusResponse, error) {
+	rsp, err := c.UpdateCatalogItemInstanceStatus(ctx, catalogItemInstanceId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCatalogItemInstanceStatusResponse(rsp)
+}
+
+// CloneCatalogItemInstanceWithBodyWithResponse request with arbitrary body returning *CloneCatalogItemInstanceResponse
+func (c *ClientWithResponses) CloneCatalogItemInstanceWithBodyWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CloneCatalogItemInstanceResponse, error) {
+	rsp, err := c.CloneCatalogItemInstanceWithBody(ctx, catalogItemInstanceId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneCatalogItemInstanceResponse(rsp)
+}
+
+func (c *ClientWithResponses) CloneCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, params *CloneCatalogItemInstanceParams, body CloneCatalogItemInstanceJSONRequestBody, reqEditors ...RequestEditorFn) (*CloneCatalogItemInstanceResponse, error) {
+	rsp, err := c.CloneCatalogItemInstance(ctx, catalogItemInstanceId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCloneCatalogItemInstanceResponse(rsp)
+}
+
+// DescribeCatalogItemInstanceWithResponse request returning *DescribeCatalogItemInstanceResponse
+func (c *ClientWithResponses) DescribeCatalogItemInstanceWithResponse(ctx context.Context, catalogItemInstanceId CatalogItemInstanceIdPath, reqEditors ...RequestEditorFn) (*DescribeCatalogItemInstanceResponse, error) {
+	rsp, err := c.DescribeCatalogItemInstance(ctx, catalogItemInstanceId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDescribeCatalogItemInstanceResponse(rsp)
+}
+
+// ListCatalogItemsWithResponse request returning *ListCatalogItemsResponse
+func (c *ClientWithResponses) ListCatalogItemsWithResponse(ctx context.Context, params *ListCatalogItemsParams, reqEditors ...RequestEditorFn) (*ListCatalogItemsResponse, error) {
+	rsp, err := c.ListCatalogItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogItemsResponse(rsp)
+}
+
+// CreateCatalogItemWithBodyWithResponse request with arbitrary body returning *CreateCatalogItemResponse
+func (c *ClientWithResponses) CreateCatalogItemWithBodyWithResponse(ctx context.Context, params *CreateCatalogItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
+	rsp, err := c.CreateCatalogItemWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateCatalogItemWithResponse(ctx context.Context, params *CreateCatalogItemParams, body CreateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateCatalogItemResponse, error) {
+	rsp, err := c.CreateCatalogItem(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateCatalogItemResponse(rsp)
+}
+
+// DeleteCatalogItemWithResponse request returning *DeleteCatalogItemResponse
+func (c *ClientWithResponses) DeleteCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, params *DeleteCatalogItemParams, reqEditors ...RequestEditorFn) (*DeleteCatalogItemResponse, error) {
+	rsp, err := c.DeleteCatalogItem(ctx, catalogItemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteCatalogItemResponse(rsp)
+}
+
+// GetCatalogItemWithResponse request returning *GetCatalogItemResponse
+func (c *ClientWithResponses) GetCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, params *GetCatalogItemParams, reqEditors ...RequestEditorFn) (*GetCatalogItemResponse, error) {
+	rsp, err := c.GetCatalogItem(ctx, catalogItemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogItemResponse(rsp)
+}
+
+// UpdateCatalogItemWithBodyWithResponse request with arbitrary body returning *UpdateCatalogItemResponse
+func (c *ClientWithResponses) UpdateCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error) {
+	rsp, err := c.UpdateCatalogItemWithBody(ctx, catalogItemId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCatalogItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateCatalogItemWithApplicationMergePatchPlusJSONBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body UpdateCatalogItemApplicationMergePatchPlusJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateCatalogItemResponse, error) {
+	rsp, err := c.UpdateCatalogItemWithApplicationMergePatchPlusJSONBody(ctx, catalogItemId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateCatalogItemResponse(rsp)
+}
+
+// GetCatalogItemIconWithResponse request returning *GetCatalogItemIconResponse
+func (c *ClientWithResponses) GetCatalogItemIconWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemIconResponse, error) {
+	rsp, err := c.GetCatalogItemIcon(ctx, catalogItemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogItemIconResponse(rsp)
+}
+
+// GetCatalogItemValidationBundleWithResponse request returning *GetCatalogItemValidationBundleResponse
+func (c *ClientWithResponses) GetCatalogItemValidationBundleWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*GetCatalogItemValidationBundleResponse, error) {
+	rsp, err := c.GetCatalogItemValidationBundle(ctx, catalogItemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCatalogItemValidationBundleResponse(rsp)
+}
+
+// ArchiveCatalogItemWithResponse request returning *ArchiveCatalogItemResponse
+func (c *ClientWithResponses) ArchiveCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*ArchiveCatalogItemResponse, error) {
+	rsp, err := c.ArchiveCatalogItem(ctx, catalogItemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseArchiveCatalogItemResponse(rsp)
+}
+
+// EstimateCatalogItemWithBodyWithResponse request with arbitrary body returning *EstimateCatalogItemResponse
+func (c *ClientWithResponses) EstimateCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*EstimateCatalogItemResponse, error) {
+	rsp, err := c.EstimateCatalogItemWithBody(ctx, catalogItemId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEstimateCatalogItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) EstimateCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body EstimateCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*EstimateCatalogItemResponse, error) {
+	rsp, err := c.EstimateCatalogItem(ctx, catalogItemId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseEstimateCatalogItemResponse(rsp)
+}
+
+// PreviewCatalogItemWithBodyWithResponse request with arbitrary body returning *PreviewCatalogItemResponse
+func (c *ClientWithResponses) PreviewCatalogItemWithBodyWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PreviewCatalogItemResponse, error) {
+	rsp, err := c.PreviewCatalogItemWithBody(ctx, catalogItemId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewCatalogItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PreviewCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, body PreviewCatalogItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PreviewCatalogItemResponse, error) {
+	rsp, err := c.PreviewCatalogItem(ctx, catalogItemId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePreviewCatalogItemResponse(rsp)
+}
+
+// PublishCatalogItemWithResponse request returning *PublishCatalogItemResponse
+func (c *ClientWithResponses) PublishCatalogItemWithResponse(ctx context.Context, catalogItemId CatalogItemIdPath, reqEditors ...RequestEditorFn) (*PublishCatalogItemResponse, error) {
+	rsp, err := c.PublishCatalogItem(ctx, catalogItemId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePublishCatalogItemResponse(rsp)
+}
+
+// ExportBackstageCatalogItemsWithResponse request returning *ExportBackstageCatalogItemsResponse
+func (c *ClientWithResponses) ExportBackstageCatalogItemsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ExportBackstageCatalogItemsResponse, error) {
+	rsp, err := c.ExportBackstageCatalogItems(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseExportBackstageCatalogItemsResponse(rsp)
+}
+
+// ListCatalogItemCategoriesWithResponse request returning *ListCatalogItemCategoriesResponse
+func (c *ClientWithResponses) ListCatalogItemCategoriesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*ListCatalogItemCategoriesResponse, error) {
+	rsp, err := c.ListCatalogItemCategories(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListCatalogItemCategoriesResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, params *GetHealthParams, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// SearchWithResponse request returning *SearchResponse
+func (c *ClientWithResponses) SearchWithResponse(ctx context.Context, params *SearchParams, reqEditors ...RequestEditorFn) (*SearchResponse, error) {
+	rsp, err := c.Search(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchResponse(rsp)
+}
+
+// ListServiceTypesWithResponse request returning *ListServiceTypesResponse
+func (c *ClientWithResponses) ListServiceTypesWithResponse(ctx context.Context, params *ListServiceTypesParams, reqEditors ...RequestEditorFn) (*ListServiceTypesResponse, error) {
+	rsp, err := c.ListServiceTypes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseListServiceTypesResponse(rsp)
+}
+
+// CreateServiceTypeWithBodyWithResponse request with arbitrary body returning *CreateServiceTypeResponse
+func (c *ClientWithResponses) CreateServiceTypeWithBodyWithResponse(ctx context.Context, params *CreateServiceTypeParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error) {
+	rsp, err := c.CreateServiceTypeWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceTypeResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateServiceTypeWithResponse(ctx context.Context, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error) {
+	rsp, err := c.CreateServiceType(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateServiceTypeResponse(rsp)
+}
+
+// GetServiceTypeWithResponse request returning *GetServiceTypeResponse
+func (c *ClientWithResponses) GetServiceTypeWithResponse(ctx context.Context, serviceTypeId ServiceTypeIdPath, params *GetServiceTypeParams, reqEditors ...RequestEditorFn) (*GetServiceTypeResponse, error) {
+	rsp, err := c.GetServiceType(ctx, serviceTypeId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetServiceTypeResponse(rsp)
+}
+
+// GetVersionWithResponse request returning *GetVersionResponse
+func (c *ClientWithResponses) GetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetVersionResponse, error) {
+	rsp, err := c.GetVersion(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetVersionResponse(rsp)
+}
+
+// ParseListCatalogItemInstancesResponse parses an HTTP response from a ListCatalogItemInstancesWithResponse call
+func ParseListCatalogItemInstancesResponse(rsp *http.Response) (*ListCatalogItemInstancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCatalogItemInstancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemInstanceList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCatalogItemInstanceResponse parses an HTTP response from a CreateCatalogItemInstanceWithResponse call
+func ParseCreateCatalogItemInstanceResponse(rsp *http.Response) (*CreateCatalogItemInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCatalogItemInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CatalogItemInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AlreadyExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCatalogItemInstanceResponse parses an HTTP response from a DeleteCatalogItemInstanceWithResponse call
+func ParseDeleteCatalogItemInstanceResponse(rsp *http.Response) (*DeleteCatalogItemInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCatalogItemInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetCatalogItemInstanceResponse parses an HTTP response from a GetCatalogItemInstanceWithResponse call
+func ParseGetCatalogItemInstanceResponse(rsp *http.Response) (*GetCatalogItemInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetCatalogItemInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCatalogItemInstanceResponse parses an HTTP response from a UpdateCatalogItemInstanceWithResponse call
+func ParseUpdateCatalogItemInstanceResponse(rsp *http.Response) (*UpdateCatalogItemInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCatalogItemInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseUpdateCatalogItemInstanceStatusResponse parses an HTTP response from a UpdateCatalogItemInstanceStatusWithResponse call
+func ParseUpdateCatalogItemInstanceStatusResponse(rsp *http.Response) (*UpdateCatalogItemInstanceStatusResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &UpdateCatalogItemInstanceStatusResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCloneCatalogItemInstanceResponse parses an HTTP response from a CloneCatalogItemInstanceWithResponse call
+func ParseCloneCatalogItemInstanceResponse(rsp *http.Response) (*CloneCatalogItemInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CloneCatalogItemInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CatalogItemInstance
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AlreadyExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDescribeCatalogItemInstanceResponse parses an HTTP response from a DescribeCatalogItemInstanceWithResponse call
+func ParseDescribeCatalogItemInstanceResponse(rsp *http.Response) (*DescribeCatalogItemInstanceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DescribeCatalogItemInstanceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemInstanceDescription
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCatalogItemsResponse parses an HTTP response from a ListCatalogItemsWithResponse call
+func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCatalogItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateCatalogItemResponse parses an HTTP response from a CreateCatalogItemWithResponse call
+func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateCatalogItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest CatalogItem
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest AlreadyExists
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteCatalogItemResponse parses an HTTP response from a DeleteCatalogItemWithResponse call
+func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteCatalogItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
 
-func (c *ClientWithResponses) CreateServiceTypeWithResponse(ctx context.Context, params *CreateServiceTypeParams, body CreateServiceTypeJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateServiceTypeResponse, error) {
-	rsp, err := c.CreateServiceType(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseCreateServiceTypeResponse(rsp)
-}
 
-// GetServiceTypeWithResponse request returning *GetServiceTypeResponse
-func (c *ClientWithResponses) GetServiceTypeWithResponse(ctx context.Context, serviceTypeId ServiceTypeIdPath, reqEditors ...RequestEditorFn) (*GetServiceTypeResponse, error) {
-	rsp, err := c.GetServiceType(ctx, serviceTypeId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetServiceTypeResponse(rsp)
+	return response, nil
 }
 
-// ParseListCatalogItemInstancesResponse parses an HTTP response from a ListCatalogItemInstancesWithResponse call
-func ParseListCatalogItemInstancesResponse(rsp *http.Response) (*ListCatalogItemInstancesResponse, error) {
+// ParseGetCatalogItemResponse parses an HTTP response from a GetCatalogItemWithResponse call
+func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCatalogItemInstancesResponse{
+	response := &GetCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItemInstanceList
+		var dest CatalogItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1608,6 +3958,13 @@ func ParseListCatalogItemInstancesResponse(rsp *http.Response) (*ListCatalogItem
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1620,26 +3977,26 @@ func ParseListCatalogItemInstancesResponse(rsp *http.Response) (*ListCatalogItem
 	return response, nil
 }
 
-// ParseCreateCatalogItemInstanceResponse parses an HTTP response from a CreateCatalogItemInstanceWithResponse call
-func ParseCreateCatalogItemInstanceResponse(rsp *http.Response) (*CreateCatalogItemInstanceResponse, error) {
+// ParseUpdateCatalogItemResponse parses an HTTP response from a UpdateCatalogItemWithResponse call
+func ParseUpdateCatalogItemResponse(rsp *http.Response) (*UpdateCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCatalogItemInstanceResponse{
+	response := &UpdateCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CatalogItemInstance
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
@@ -1662,12 +4019,12 @@ func ParseCreateCatalogItemInstanceResponse(rsp *http.Response) (*CreateCatalogI
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest AlreadyExists
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON409 = &dest
+		response.JSON404 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
@@ -1681,15 +4038,15 @@ func ParseCreateCatalogItemInstanceResponse(rsp *http.Response) (*CreateCatalogI
 	return response, nil
 }
 
-// ParseDeleteCatalogItemInstanceResponse parses an HTTP response from a DeleteCatalogItemInstanceWithResponse call
-func ParseDeleteCatalogItemInstanceResponse(rsp *http.Response) (*DeleteCatalogItemInstanceResponse, error) {
+// ParseGetCatalogItemIconResponse parses an HTTP response from a GetCatalogItemIconWithResponse call
+func ParseGetCatalogItemIconResponse(rsp *http.Response) (*GetCatalogItemIconResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCatalogItemInstanceResponse{
+	response := &GetCatalogItemIconResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1710,7 +4067,7 @@ func ParseDeleteCatalogItemInstanceResponse(rsp *http.Response) (*DeleteCatalogI
 		response.JSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1728,22 +4085,22 @@ func ParseDeleteCatalogItemInstanceResponse(rsp *http.Response) (*DeleteCatalogI
 	return response, nil
 }
 
-// ParseGetCatalogItemInstanceResponse parses an HTTP response from a GetCatalogItemInstanceWithResponse call
-func ParseGetCatalogItemInstanceResponse(rsp *http.Response) (*GetCatalogItemInstanceResponse, error) {
+// ParseGetCatalogItemValidationBundleResponse parses an HTTP response from a GetCatalogItemValidationBundleWithResponse call
+func ParseGetCatalogItemValidationBundleResponse(rsp *http.Response) (*GetCatalogItemValidationBundleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogItemInstanceResponse{
+	response := &GetCatalogItemValidationBundleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItemInstance
+		var dest ValidationBundle
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1782,22 +4139,22 @@ func ParseGetCatalogItemInstanceResponse(rsp *http.Response) (*GetCatalogItemIns
 	return response, nil
 }
 
-// ParseListCatalogItemsResponse parses an HTTP response from a ListCatalogItemsWithResponse call
-func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsResponse, error) {
+// ParseArchiveCatalogItemResponse parses an HTTP response from a ArchiveCatalogItemWithResponse call
+func ParseArchiveCatalogItemResponse(rsp *http.Response) (*ArchiveCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &ListCatalogItemsResponse{
+	response := &ArchiveCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItemList
+		var dest CatalogItem
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1817,6 +4174,20 @@ func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsRespons
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1829,26 +4200,26 @@ func ParseListCatalogItemsResponse(rsp *http.Response) (*ListCatalogItemsRespons
 	return response, nil
 }
 
-// ParseCreateCatalogItemResponse parses an HTTP response from a CreateCatalogItemWithResponse call
-func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemResponse, error) {
+// ParseEstimateCatalogItemResponse parses an HTTP response from a EstimateCatalogItemWithResponse call
+func ParseEstimateCatalogItemResponse(rsp *http.Response) (*EstimateCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateCatalogItemResponse{
+	response := &EstimateCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
-		var dest CatalogItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CostEstimate
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest Error
@@ -1871,8 +4242,15 @@ func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemRespo
 		}
 		response.JSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
-		var dest AlreadyExists
+		var dest Error
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1890,20 +4268,34 @@ func ParseCreateCatalogItemResponse(rsp *http.Response) (*CreateCatalogItemRespo
 	return response, nil
 }
 
-// ParseDeleteCatalogItemResponse parses an HTTP response from a DeleteCatalogItemWithResponse call
-func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemResponse, error) {
+// ParsePreviewCatalogItemResponse parses an HTTP response from a PreviewCatalogItemWithResponse call
+func ParsePreviewCatalogItemResponse(rsp *http.Response) (*PreviewCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteCatalogItemResponse{
+	response := &PreviewCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CatalogItemPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1937,15 +4329,15 @@ func ParseDeleteCatalogItemResponse(rsp *http.Response) (*DeleteCatalogItemRespo
 	return response, nil
 }
 
-// ParseGetCatalogItemResponse parses an HTTP response from a GetCatalogItemWithResponse call
-func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, error) {
+// ParsePublishCatalogItemResponse parses an HTTP response from a PublishCatalogItemWithResponse call
+func ParsePublishCatalogItemResponse(rsp *http.Response) (*PublishCatalogItemResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetCatalogItemResponse{
+	response := &PublishCatalogItemResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1979,6 +4371,13 @@ func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, e
 		}
 		response.JSON404 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1991,33 +4390,73 @@ func ParseGetCatalogItemResponse(rsp *http.Response) (*GetCatalogItemResponse, e
 	return response, nil
 }
 
-// ParseUpdateCatalogItemResponse parses an HTTP response from a UpdateCatalogItemWithResponse call
-func ParseUpdateCatalogItemResponse(rsp *http.Response) (*UpdateCatalogItemResponse, error) {
+// ParseExportBackstageCatalogItemsResponse parses an HTTP response from a ExportBackstageCatalogItemsWithResponse call
+func ParseExportBackstageCatalogItemsResponse(rsp *http.Response) (*ExportBackstageCatalogItemsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateCatalogItemResponse{
+	response := &ExportBackstageCatalogItemsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest CatalogItem
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON401 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
-		var dest Error
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON400 = &dest
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "yaml") && rsp.StatusCode == 200:
+		var dest string
+		if err := yaml.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.YAML200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseListCatalogItemCategoriesResponse parses an HTTP response from a ListCatalogItemCategoriesWithResponse call
+func ParseListCatalogItemCategoriesResponse(rsp *http.Response) (*ListCatalogItemCategoriesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &ListCatalogItemCategoriesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest CategoryList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
 		var dest Unauthorized
@@ -2033,13 +4472,6 @@ func ParseUpdateCatalogItemResponse(rsp *http.Response) (*UpdateCatalogItemRespo
 		}
 		response.JSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
-		var dest NotFound
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON404 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest InternalServerError
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -2078,6 +4510,60 @@ func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
 	return response, nil
 }
 
+// ParseSearchResponse parses an HTTP response from a SearchWithResponse call
+func ParseSearchResponse(rsp *http.Response) (*SearchResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SearchResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SearchResultList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
 // ParseListServiceTypesResponse parses an HTTP response from a ListServiceTypesWithResponse call
 func ParseListServiceTypesResponse(rsp *http.Response) (*ListServiceTypesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
@@ -2246,3 +4732,29 @@ func ParseGetServiceTypeResponse(rsp *http.Response) (*GetServiceTypeResponse, e
 
 	return response, nil
 }
+
+// ParseGetVersionResponse parses an HTTP response from a GetVersionWithResponse call
+func ParseGetVersionResponse(rsp *http.Response) (*GetVersionResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetVersionResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Version
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
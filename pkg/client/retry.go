@@ -0,0 +1,123 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy is how requests are retried when the server is unavailable or
+// rate limits them. Only idempotent requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the number of attempts, including the first one
+	MaxAttempts int
+	// InitialBackoff is the delay before the first retry; it doubles on
+	// each retry, up to MaxBackoff. A Retry-After header overrides it.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// DefaultRetryPolicy is used unless WithRetry is given.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 200 * time.Millisecond,
+	MaxBackoff:     5 * time.Second,
+}
+
+// retryTransport retries the idempotent requests failing with a network
+// error, 429, 502, 503 or 504.
+type retryTransport struct {
+	next    http.RoundTripper
+	policy  RetryPolicy
+	timeout time.Duration
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	backoff := t.policy.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		resp, err := t.attempt(req)
+		if attempt >= t.policy.MaxAttempts || !idempotent(req) || !retryable(req, resp, err) {
+			return resp, err
+		}
+
+		delay := min(backoff, t.policy.MaxBackoff)
+		if resp != nil {
+			if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && retryAfter >= 0 {
+				delay = time.Duration(retryAfter) * time.Second
+			}
+			resp.Body.Close()
+		}
+		select {
+		case <-time.After(delay):
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		}
+		backoff *= 2
+
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return nil, errors.New("cannot retry a request whose body cannot be rewound")
+			}
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// attempt sends req bounded by the timeout of an attempt. The timeout is
+// cancelled once the response body is closed.
+func (t *retryTransport) attempt(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.next.RoundTrip(req)
+	}
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+	resp, err := t.next.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// cancelOnClose cancels the context of a request once its response body is
+// closed.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
+
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func retryable(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// Only the caller's context being done is not worth retrying; the
+		// timeout of a single attempt is
+		return req.Context().Err() == nil
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}